@@ -0,0 +1,17 @@
+resource "pfsense_dnsresolver_hostoverride" "example" {
+  host         = "app"
+  domain       = "example.com"
+  ip_addresses = ["10.10.10.10"]
+}
+
+check "app_resolves" {
+  data "pfsense_dns_lookup" "app" {
+    name        = pfsense_dnsresolver_hostoverride.example.fqdn
+    record_type = "A"
+  }
+
+  assert {
+    condition     = contains([for a in data.pfsense_dns_lookup.app.answers : a.data], "10.10.10.10")
+    error_message = "Host override is not resolving from the firewall."
+  }
+}
@@ -0,0 +1,8 @@
+data "pfsense_dnsresolver_cache" "this" {
+  name = "example.com"
+  type = "A"
+}
+
+output "cached_addresses" {
+  value = [for r in data.pfsense_dnsresolver_cache.this.records : r.data]
+}
@@ -0,0 +1,5 @@
+data "pfsense_dnsresolver_infra_cache" "this" {}
+
+output "slow_servers" {
+  value = distinct([for e in data.pfsense_dnsresolver_infra_cache.this.all : e.address if !e.expired && e.rtt > 250])
+}
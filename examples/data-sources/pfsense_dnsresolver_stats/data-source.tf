@@ -0,0 +1,8 @@
+data "pfsense_dnsresolver_stats" "this" {}
+
+check "dns_cache" {
+  assert {
+    condition     = data.pfsense_dnsresolver_stats.this.cache_hit_ratio > 0.5
+    error_message = "DNS resolver cache hit ratio is below 50%."
+  }
+}
@@ -0,0 +1,7 @@
+data "pfsense_firewall_alias_references" "this" {
+  name = "access_points"
+}
+
+output "access_points_in_use" {
+  value = data.pfsense_firewall_alias_references.this.in_use
+}
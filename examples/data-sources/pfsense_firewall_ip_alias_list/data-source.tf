@@ -0,0 +1,20 @@
+# blocklist.txt contains one address per line, for example "203.0.113.0/24 scanner network"
+data "pfsense_firewall_ip_alias_list" "blocklist" {
+  content = file("${path.module}/blocklist.txt")
+}
+
+resource "pfsense_firewall_ip_alias" "blocklist" {
+  name    = "blocklist"
+  type    = "network"
+  entries = data.pfsense_firewall_ip_alias_list.blocklist.entries
+}
+
+# render entries as CSV
+data "pfsense_firewall_ip_alias_list" "export" {
+  format  = "csv"
+  entries = pfsense_firewall_ip_alias.blocklist.entries
+}
+
+output "blocklist_csv" {
+  value = data.pfsense_firewall_ip_alias_list.export.content
+}
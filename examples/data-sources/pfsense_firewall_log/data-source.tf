@@ -0,0 +1,9 @@
+data "pfsense_firewall_log" "this" {
+  interface = "wan"
+  action    = "block"
+  since     = "2024-01-01T00:00:00Z"
+}
+
+output "blocked" {
+  value = data.pfsense_firewall_log.this.all
+}
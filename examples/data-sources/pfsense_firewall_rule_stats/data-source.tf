@@ -0,0 +1,5 @@
+data "pfsense_firewall_rule_stats" "this" {}
+
+output "unused_rules" {
+  value = [for r in data.pfsense_firewall_rule_stats.this.all : r.description if r.tracker != null && r.packets == 0]
+}
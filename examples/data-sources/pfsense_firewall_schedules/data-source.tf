@@ -0,0 +1,5 @@
+data "pfsense_firewall_schedules" "this" {}
+
+output "schedules" {
+  value = data.pfsense_firewall_schedules.this.all
+}
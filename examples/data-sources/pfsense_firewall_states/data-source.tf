@@ -0,0 +1,8 @@
+data "pfsense_firewall_states" "this" {
+  interface = "wan"
+  port      = 443
+}
+
+output "states" {
+  value = data.pfsense_firewall_states.this.all
+}
@@ -0,0 +1,7 @@
+data "pfsense_firewall_table" "this" {
+  name = "bogons"
+}
+
+output "bogons" {
+  value = data.pfsense_firewall_table.this.entries
+}
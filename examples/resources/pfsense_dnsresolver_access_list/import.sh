@@ -0,0 +1 @@
+terraform import pfsense_dnsresolver_access_list.example internal
@@ -0,0 +1,14 @@
+resource "pfsense_dnsresolver_access_list" "example" {
+  name        = "internal"
+  action      = "allow"
+  description = "internal networks"
+  networks = [
+    {
+      network     = "10.0.0.0/8"
+      description = "datacenter"
+    },
+    {
+      network = "fd00::/8"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import pfsense_dnsresolver_advanced.example advanced
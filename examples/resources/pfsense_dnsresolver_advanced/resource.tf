@@ -0,0 +1,12 @@
+resource "pfsense_dnsresolver_advanced" "example" {
+  message_cache_size = 50
+  prefetch           = true
+  serve_expired      = true
+  minimum_ttl        = 60
+  maximum_ttl        = 86400
+  hide_identity      = true
+  hide_version       = true
+  aggressive_nsec    = true
+  log_verbosity      = 1
+  edns_buffer_size   = 1232
+}
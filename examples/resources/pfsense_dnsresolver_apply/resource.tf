@@ -6,11 +6,9 @@ resource "pfsense_dnsresolver_hostoverride" "example" {
   apply        = false
 }
 
-# apply once
+# apply once, again whenever a host override changes
 resource "pfsense_dnsresolver_apply" "example" {
-  lifecycle {
-    replace_triggered_by = [
-      pfsense_dnsresolver_hostoverride.example,
-    ]
+  triggers = {
+    for k, v in pfsense_dnsresolver_hostoverride.example : k => jsonencode(v)
   }
 }
@@ -0,0 +1,11 @@
+resource "pfsense_dnsresolver_general" "example" {
+  enabled                       = true
+  listen_interfaces             = ["lan", "lo0"]
+  outgoing_interfaces           = ["wan"]
+  system_domain_local_zone_type = "static"
+  dnssec                        = true
+  register_dhcp_static_mappings = true
+  custom_options                = <<-EOT
+    private-domain: "example.com"
+  EOT
+}
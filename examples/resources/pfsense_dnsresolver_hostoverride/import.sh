@@ -1,2 +1,5 @@
 # specify in format 'host,domain'
 terraform import pfsense_dnsresolver_hostoverride.example bar.baz,foo.com
+
+# or by fully qualified domain name
+terraform import pfsense_dnsresolver_hostoverride.example bar.baz.foo.com
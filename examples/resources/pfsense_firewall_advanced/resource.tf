@@ -0,0 +1,6 @@
+resource "pfsense_firewall_advanced" "example" {
+  optimization_mode       = "conservative"
+  maximum_states          = 400000
+  bogons_update_frequency = "weekly"
+  nat_reflection_mode     = "purenat"
+}
@@ -0,0 +1,3 @@
+resource "pfsense_firewall_carp_maintenance_mode" "example" {
+  enabled = true
+}
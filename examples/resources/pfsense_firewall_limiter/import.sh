@@ -0,0 +1,2 @@
+# limiter name
+terraform import pfsense_firewall_limiter.example guest_down
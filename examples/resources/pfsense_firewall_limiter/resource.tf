@@ -0,0 +1,17 @@
+resource "pfsense_firewall_limiter" "example" {
+  name        = "guest_down"
+  description = "per user guest download limit"
+  bandwidths = [
+    {
+      bandwidth = 20
+    },
+    {
+      bandwidth = 5
+      schedule  = "business_hours"
+    },
+  ]
+  mask           = "destination"
+  mask_ipv4_bits = 32
+  scheduler      = "fq_codel"
+  fq_codel       = {}
+}
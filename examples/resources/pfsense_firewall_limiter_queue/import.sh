@@ -0,0 +1,2 @@
+# queue name
+terraform import pfsense_firewall_limiter_queue.example wan_down_bulk
@@ -0,0 +1,16 @@
+resource "pfsense_firewall_limiter" "example" {
+  name = "wan_down"
+  bandwidths = [
+    {
+      bandwidth = 500
+    },
+  ]
+}
+
+resource "pfsense_firewall_limiter_queue" "example" {
+  name             = "wan_down_bulk"
+  limiter          = pfsense_firewall_limiter.example.name
+  weight           = 10
+  queue_management = "codel"
+  ecn              = true
+}
@@ -0,0 +1,2 @@
+# mapping creation time (unix timestamp)
+terraform import pfsense_firewall_nat_npt.example 1697650000
@@ -0,0 +1,6 @@
+resource "pfsense_firewall_nat_npt" "example" {
+  interface       = "wan"
+  internal_prefix = "fd00:1234::/48"
+  external_prefix = "2001:db8:1::/48"
+  description     = "DMZ"
+}
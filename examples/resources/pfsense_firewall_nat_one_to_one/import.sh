@@ -0,0 +1,2 @@
+# mapping creation time (unix timestamp)
+terraform import pfsense_firewall_nat_one_to_one.example 1697650000
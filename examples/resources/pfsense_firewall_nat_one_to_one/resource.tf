@@ -0,0 +1,15 @@
+# single host
+resource "pfsense_firewall_nat_one_to_one" "example" {
+  interface        = "wan"
+  external_address = "203.0.113.10"
+  internal_address = "192.168.1.10"
+  description      = "mail server"
+}
+
+# subnet
+resource "pfsense_firewall_nat_one_to_one" "subnet_example" {
+  interface        = "wan"
+  external_address = "203.0.113.16"
+  internal_address = "172.16.0.16/28"
+  description      = "DMZ"
+}
@@ -0,0 +1,2 @@
+# mapping creation time (unix timestamp)
+terraform import pfsense_firewall_nat_outbound_mapping.example 1697650000
@@ -0,0 +1,21 @@
+resource "pfsense_firewall_nat_outbound_mode" "example" {
+  mode = "hybrid"
+}
+
+# translate a subnet to a virtual IP on the second WAN
+resource "pfsense_firewall_nat_outbound_mapping" "example" {
+  interface           = "opt1"
+  source_address      = "192.168.10.0/24"
+  translation_address = "203.0.113.10"
+  description         = "guest network"
+  after               = ""
+}
+
+# skip translation for VPN traffic, evaluated after the mapping above
+resource "pfsense_firewall_nat_outbound_mapping" "no_nat_example" {
+  interface           = "wan"
+  source_address      = "192.168.1.0/24"
+  destination_address = "10.8.0.0/16"
+  no_nat              = true
+  after               = pfsense_firewall_nat_outbound_mapping.example.id
+}
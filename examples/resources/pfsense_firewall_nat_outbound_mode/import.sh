@@ -0,0 +1,2 @@
+# current outbound NAT mode
+terraform import pfsense_firewall_nat_outbound_mode.example hybrid
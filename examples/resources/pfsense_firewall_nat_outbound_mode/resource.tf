@@ -0,0 +1,3 @@
+resource "pfsense_firewall_nat_outbound_mode" "example" {
+  mode = "hybrid"
+}
@@ -0,0 +1,2 @@
+# port forward creation time (unix timestamp)
+terraform import pfsense_firewall_nat_port_forward.example 1697650000
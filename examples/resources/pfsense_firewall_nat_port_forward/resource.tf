@@ -0,0 +1,29 @@
+# web server example
+resource "pfsense_firewall_nat_port_forward" "example" {
+  interface            = "wan"
+  protocol             = "tcp"
+  destination_port     = "443"
+  redirect_target_ip   = "192.168.1.10"
+  redirect_target_port = "443"
+  description          = "web server"
+}
+
+# alias example
+resource "pfsense_firewall_ip_alias" "game_servers" {
+  name = "game_servers"
+  type = "host"
+  entries = [
+    { address = "192.168.1.20" },
+  ]
+}
+
+resource "pfsense_firewall_nat_port_forward" "alias_example" {
+  interface               = "wan"
+  protocol                = "udp"
+  source_address          = "203.0.113.0/24"
+  destination_port        = "27015-27030"
+  redirect_target_ip      = pfsense_firewall_ip_alias.game_servers.name
+  redirect_target_port    = "27015"
+  nat_reflection          = "purenat"
+  filter_rule_association = "pass"
+}
@@ -0,0 +1,2 @@
+# schedule name
+terraform import pfsense_firewall_schedule.example guest_wifi
@@ -0,0 +1,29 @@
+resource "pfsense_firewall_schedule" "example" {
+  name        = "guest_wifi"
+  description = "guest wifi outside business hours"
+  time_ranges = [
+    {
+      days_of_week = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      start_time   = "00:00"
+      stop_time    = "08:00"
+      description  = "weekday mornings"
+    },
+    {
+      days_of_week = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      start_time   = "17:00"
+      stop_time    = "23:59"
+      description  = "weekday evenings"
+    },
+    {
+      days_of_week = ["saturday", "sunday"]
+      start_time   = "00:00"
+      stop_time    = "23:59"
+    },
+    {
+      dates       = ["12-24", "12-25", "12-26"]
+      start_time  = "00:00"
+      stop_time   = "23:59"
+      description = "holidays"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# interface
+terraform import pfsense_firewall_shaper.example wan
@@ -0,0 +1,5 @@
+resource "pfsense_firewall_shaper" "example" {
+  interface = "wan"
+  scheduler = "PRIQ"
+  bandwidth = 100
+}
@@ -0,0 +1,2 @@
+# interface/name
+terraform import pfsense_firewall_shaper_queue.example wan/qVoIP
@@ -0,0 +1,29 @@
+resource "pfsense_firewall_shaper" "example" {
+  interface = "wan"
+  scheduler = "HFSC"
+  bandwidth = 100
+}
+
+resource "pfsense_firewall_shaper_queue" "default" {
+  interface = pfsense_firewall_shaper.example.interface
+  name      = "qDefault"
+  bandwidth = 60
+  default   = true
+  codel     = true
+}
+
+resource "pfsense_firewall_shaper_queue" "ack" {
+  interface   = pfsense_firewall_shaper.example.interface
+  name        = "qACK"
+  description = "assigned to ACK packets by filter rules"
+  bandwidth   = 20
+}
+
+resource "pfsense_firewall_shaper_queue" "voip" {
+  interface = pfsense_firewall_shaper.example.interface
+  name      = "qVoIP"
+  priority  = 7
+  bandwidth = 20
+  red       = true
+  ecn       = true
+}
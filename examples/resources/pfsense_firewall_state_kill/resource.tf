@@ -0,0 +1,20 @@
+resource "pfsense_firewall_nat_port_forward" "example" {
+  interface            = "wan"
+  protocol             = "tcp"
+  destination_port     = "443"
+  redirect_target_ip   = "192.168.1.10"
+  redirect_target_port = "443"
+  description          = "web server"
+}
+
+# kill existing states whenever the port forward changes
+resource "pfsense_firewall_state_kill" "example" {
+  interface = "wan"
+  port      = 443
+
+  lifecycle {
+    replace_triggered_by = [
+      pfsense_firewall_nat_port_forward.example,
+    ]
+  }
+}
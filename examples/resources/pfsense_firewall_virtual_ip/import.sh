@@ -0,0 +1,2 @@
+# virtual IP unique ID
+terraform import pfsense_firewall_virtual_ip.example 652f1a2b3c4d5
@@ -0,0 +1,14 @@
+resource "pfsense_firewall_virtual_ip" "carp" {
+  mode        = "carp"
+  interface   = "lan"
+  address     = "192.168.1.1/24"
+  vhid        = 1
+  password    = var.carp_password
+  description = "LAN gateway"
+}
+
+resource "pfsense_firewall_virtual_ip" "alias" {
+  mode      = "ipalias"
+  interface = "wan"
+  address   = "203.0.113.10/32"
+}
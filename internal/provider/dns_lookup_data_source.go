@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSLookupDataSource{}
+)
+
+func NewDNSLookupDataSource() datasource.DataSource {
+	return &DNSLookupDataSource{}
+}
+
+type DNSLookupDataSource struct {
+	client *pfsense.Client
+}
+
+type DNSLookupDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	RecordType types.String `tfsdk:"record_type"`
+	Servers    types.List   `tfsdk:"servers"`
+	Answers    types.List   `tfsdk:"answers"`
+	Timings    types.List   `tfsdk:"timings"`
+}
+
+type DNSLookupAnswerModel struct {
+	Type types.String `tfsdk:"type"`
+	Data types.String `tfsdk:"data"`
+}
+
+type DNSLookupTimingModel struct {
+	Server    types.String `tfsdk:"server"`
+	Responded types.Bool   `tfsdk:"responded"`
+	QueryTime types.Int64  `tfsdk:"query_time"`
+	Error     types.String `tfsdk:"error"`
+}
+
+func (m DNSLookupAnswerModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type": types.StringType,
+		"data": types.StringType,
+	}}
+}
+
+func (m DNSLookupTimingModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"server":     types.StringType,
+		"responded":  types.BoolType,
+		"query_time": types.Int64Type,
+		"error":      types.StringType,
+	}}
+}
+
+func (m *DNSLookupDataSourceModel) SetFromValue(ctx context.Context, result *pfsense.DNSLookupResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	answers := []DNSLookupAnswerModel{}
+	for _, answer := range result.Answers {
+		answers = append(answers, DNSLookupAnswerModel{
+			Type: types.StringValue(answer.Type),
+			Data: types.StringValue(answer.Data),
+		})
+	}
+
+	m.Answers, d = types.ListValueFrom(ctx, DNSLookupAnswerModel{}.GetAttrType(), answers)
+	diags.Append(d...)
+
+	timings := []DNSLookupTimingModel{}
+	for _, timing := range result.Timings {
+		timingModel := DNSLookupTimingModel{
+			Server:    types.StringValue(timing.Server),
+			Responded: types.BoolValue(timing.Responded()),
+			QueryTime: types.Int64Null(),
+			Error:     types.StringNull(),
+		}
+
+		if timing.Responded() {
+			timingModel.QueryTime = types.Int64Value(timing.QueryTime.Milliseconds())
+		} else {
+			timingModel.Error = types.StringValue(timing.Error)
+		}
+
+		timings = append(timings, timingModel)
+	}
+
+	m.Timings, d = types.ListValueFrom(ctx, DNSLookupTimingModel{}.GetAttrType(), timings)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (m DNSLookupDataSourceModel) Value(ctx context.Context) (*pfsense.DNSLookup, diag.Diagnostics) {
+	var lookup pfsense.DNSLookup
+	var err error
+	var diags diag.Diagnostics
+
+	err = lookup.SetName(m.Name.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !m.RecordType.IsNull() {
+		err = lookup.SetRecordType(m.RecordType.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("record_type"),
+				"Record type cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !m.Servers.IsNull() {
+		var servers []string
+		diags.Append(m.Servers.ElementsAs(ctx, &servers, false)...)
+
+		err = lookup.SetServers(servers)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("servers"),
+				"Servers cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &lookup, diags
+}
+
+func (d *DNSLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dns_lookup", req.ProviderTypeName)
+}
+
+func (d *DNSLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Looks up a name from the firewall itself with Diagnostics > DNS Lookup, which resolves the name with the system resolver and times a query to each system name server. Useful in check blocks to verify host overrides and other DNS resolver changes are live.",
+		MarkdownDescription: "Looks up a name from the firewall itself with [Diagnostics > DNS Lookup](https://docs.netgate.com/pfsense/en/latest/diagnostics/dns-lookup.html), which resolves the name with the system resolver and times a query to each system name server. Useful in `check` blocks to verify host overrides and other DNS resolver changes are live.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Host name or IP address to look up, an IP address is looked up in reverse.",
+				Required:    true,
+			},
+			"record_type": schema.StringAttribute{
+				Description:         fmt.Sprintf("Only return answers of this record type. Options: '%s'.", strings.Join(pfsense.DNSLookupRecordTypes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Only return answers of this record type. Options: `%s`.", strings.Join(pfsense.DNSLookupRecordTypes, "`, `")),
+				Optional:            true,
+			},
+			"servers": schema.ListAttribute{
+				Description: "Only return timings of these name servers, each must be one of the system name servers.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"answers": schema.ListNestedAttribute{
+				Description: "Records returned by the system resolver.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the record.",
+							Computed:    true,
+						},
+						"data": schema.StringAttribute{
+							Description: "Address or name of the record.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"timings": schema.ListNestedAttribute{
+				Description: "Query time of each name server.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							Description: "Name server queried.",
+							Computed:    true,
+						},
+						"responded": schema.BoolAttribute{
+							Description: "Name server responded to the query.",
+							Computed:    true,
+						},
+						"query_time": schema.Int64Attribute{
+							Description: "Time taken by the name server to respond, in milliseconds, null if it did not respond.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Reason reported when the name server did not respond.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSLookupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookupReq, diags := data.Value(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.LookupDNS(ctx, *lookupReq)
+	if addError(&resp.Diagnostics, "Unable to look up name", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, result)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverAccessListResource{}
+var _ resource.ResourceWithImportState = &DNSResolverAccessListResource{}
+
+func NewDNSResolverAccessListResource() resource.Resource {
+	return &DNSResolverAccessListResource{}
+}
+
+type DNSResolverAccessListResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverAccessListResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Action      types.String `tfsdk:"action"`
+	Description types.String `tfsdk:"description"`
+	Networks    types.List   `tfsdk:"networks"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type DNSResolverAccessListNetworkResourceModel struct {
+	Network     types.String `tfsdk:"network"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r DNSResolverAccessListNetworkResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"network":     types.StringType,
+		"description": types.StringType,
+	}}
+}
+
+func (r *DNSResolverAccessListResourceModel) SetFromValue(ctx context.Context, accessList *pfsense.AccessList) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(accessList.Name)
+	r.Action = types.StringValue(accessList.Action)
+
+	if accessList.Description != "" {
+		r.Description = types.StringValue(accessList.Description)
+	}
+
+	networks := []DNSResolverAccessListNetworkResourceModel{}
+	for _, network := range accessList.Networks {
+		var networkModel DNSResolverAccessListNetworkResourceModel
+
+		networkModel.Network = types.StringValue(network.Network.String())
+
+		if network.Description != "" {
+			networkModel.Description = types.StringValue(network.Description)
+		}
+
+		networks = append(networks, networkModel)
+	}
+
+	r.Networks, diags = types.ListValueFrom(ctx, DNSResolverAccessListNetworkResourceModel{}.GetAttrType(), networks)
+	return diags
+}
+
+func (r DNSResolverAccessListResourceModel) Value(ctx context.Context) (*pfsense.AccessList, diag.Diagnostics) {
+	var accessList pfsense.AccessList
+	var err error
+	var diags diag.Diagnostics
+
+	var networkModels []*DNSResolverAccessListNetworkResourceModel
+	diags = r.Networks.ElementsAs(ctx, &networkModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = accessList.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = accessList.SetAction(r.Action.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("action"),
+			"Action cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = accessList.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	for i, networkModel := range networkModels {
+		var network pfsense.AccessListNetwork
+
+		err = network.SetNetwork(networkModel.Network.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("networks").AtListIndex(i).AtName("network"),
+				"Network cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !networkModel.Description.IsNull() {
+			err = network.SetDescription(networkModel.Description.ValueString())
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("networks").AtListIndex(i).AtName("description"),
+					"Network description cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		accessList.Networks = append(accessList.Networks, network)
+	}
+
+	return &accessList, diags
+}
+
+func (r *DNSResolverAccessListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_access_list", req.ProviderTypeName)
+}
+
+func (r *DNSResolverAccessListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS resolver access list. Networks which are allowed, denied, or refused access to the resolver. Access lists are identified by name, which must be unique.",
+		MarkdownDescription: "DNS resolver [access list](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-access-lists.html). Networks which are allowed, denied, or refused access to the resolver. Access lists are identified by name, which must be unique.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the access list.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description:         fmt.Sprintf("Action taken for queries from the networks, options: '%s'.", strings.Join(pfsense.AccessListActions, "', '")),
+				MarkdownDescription: fmt.Sprintf("Action taken for queries from the networks, options: `%s`.", strings.Join(pfsense.AccessListActions, "`, `")),
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "Networks the action applies to.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network": schema.StringAttribute{
+							Description: "IPv4 or IPv6 network in CIDR notation.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverAccessListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverAccessListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSResolverAccessListResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessListReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessList, err := r.client.CreateDNSResolverAccessList(ctx, *accessListReq)
+	if addError(&resp.Diagnostics, "Error creating access list", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, accessList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying access list", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverAccessListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverAccessListResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessList, err := r.client.GetDNSResolverAccessList(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading access list", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, accessList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverAccessListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSResolverAccessListResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessListReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessList, err := r.client.UpdateDNSResolverAccessList(ctx, *accessListReq)
+	if addError(&resp.Diagnostics, "Error updating access list", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, accessList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying access list", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverAccessListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DNSResolverAccessListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverAccessList(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting access list", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying access list", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverAccessListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverAdvancedResource{}
+var _ resource.ResourceWithImportState = &DNSResolverAdvancedResource{}
+
+func NewDNSResolverAdvancedResource() resource.Resource {
+	return &DNSResolverAdvancedResource{}
+}
+
+type DNSResolverAdvancedResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverAdvancedResourceModel struct {
+	MessageCacheSize         types.Int64 `tfsdk:"message_cache_size"`
+	InfrastructureCacheHosts types.Int64 `tfsdk:"infrastructure_cache_hosts"`
+	Prefetch                 types.Bool  `tfsdk:"prefetch"`
+	PrefetchDNSKey           types.Bool  `tfsdk:"prefetch_dns_key"`
+	ServeExpired             types.Bool  `tfsdk:"serve_expired"`
+	MinimumTTL               types.Int64 `tfsdk:"minimum_ttl"`
+	MaximumTTL               types.Int64 `tfsdk:"maximum_ttl"`
+	HideIdentity             types.Bool  `tfsdk:"hide_identity"`
+	HideVersion              types.Bool  `tfsdk:"hide_version"`
+	AggressiveNSEC           types.Bool  `tfsdk:"aggressive_nsec"`
+	LogVerbosity             types.Int64 `tfsdk:"log_verbosity"`
+	EDNSBufferSize           types.Int64 `tfsdk:"edns_buffer_size"`
+	Apply                    types.Bool  `tfsdk:"apply"`
+}
+
+func (r *DNSResolverAdvancedResourceModel) SetFromValue(ctx context.Context, adv *pfsense.DNSResolverAdvanced) diag.Diagnostics {
+	r.MessageCacheSize = types.Int64Value(int64(adv.MessageCacheSize))
+	r.InfrastructureCacheHosts = types.Int64Value(int64(adv.InfrastructureHosts))
+	r.Prefetch = types.BoolValue(adv.Prefetch)
+	r.PrefetchDNSKey = types.BoolValue(adv.PrefetchDNSKey)
+	r.ServeExpired = types.BoolValue(adv.ServeExpired)
+	r.MinimumTTL = types.Int64Value(int64(adv.MinimumTTL))
+	r.MaximumTTL = types.Int64Value(int64(adv.MaximumTTL))
+	r.HideIdentity = types.BoolValue(adv.HideIdentity)
+	r.HideVersion = types.BoolValue(adv.HideVersion)
+	r.AggressiveNSEC = types.BoolValue(adv.AggressiveNSEC)
+	r.LogVerbosity = types.Int64Value(int64(adv.LogVerbosity))
+	r.EDNSBufferSize = optionalInt64Value(adv.EDNSBufferSize)
+
+	return nil
+}
+
+func (r DNSResolverAdvancedResourceModel) Value(ctx context.Context, current pfsense.DNSResolverAdvanced) (*pfsense.DNSResolverAdvanced, diag.Diagnostics) {
+	adv := current
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.MessageCacheSize.IsNull() && !r.MessageCacheSize.IsUnknown() {
+		err = adv.SetMessageCacheSize(int(r.MessageCacheSize.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("message_cache_size"),
+				"Message cache size cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.InfrastructureCacheHosts.IsNull() && !r.InfrastructureCacheHosts.IsUnknown() {
+		err = adv.SetInfrastructureHosts(int(r.InfrastructureCacheHosts.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("infrastructure_cache_hosts"),
+				"Infrastructure cache hosts cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Prefetch.IsNull() && !r.Prefetch.IsUnknown() {
+		err = adv.SetPrefetch(r.Prefetch.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("prefetch"),
+				"Prefetch cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.PrefetchDNSKey.IsNull() && !r.PrefetchDNSKey.IsUnknown() {
+		err = adv.SetPrefetchDNSKey(r.PrefetchDNSKey.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("prefetch_dns_key"),
+				"Prefetch DNS key cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.ServeExpired.IsNull() && !r.ServeExpired.IsUnknown() {
+		err = adv.SetServeExpired(r.ServeExpired.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("serve_expired"),
+				"Serve expired cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.MinimumTTL.IsNull() && !r.MinimumTTL.IsUnknown() {
+		err = adv.SetMinimumTTL(int(r.MinimumTTL.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("minimum_ttl"),
+				"Minimum TTL cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.MaximumTTL.IsNull() && !r.MaximumTTL.IsUnknown() {
+		err = adv.SetMaximumTTL(int(r.MaximumTTL.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("maximum_ttl"),
+				"Maximum TTL cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.HideIdentity.IsNull() && !r.HideIdentity.IsUnknown() {
+		err = adv.SetHideIdentity(r.HideIdentity.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("hide_identity"),
+				"Hide identity cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.HideVersion.IsNull() && !r.HideVersion.IsUnknown() {
+		err = adv.SetHideVersion(r.HideVersion.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("hide_version"),
+				"Hide version cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.AggressiveNSEC.IsNull() && !r.AggressiveNSEC.IsUnknown() {
+		err = adv.SetAggressiveNSEC(r.AggressiveNSEC.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("aggressive_nsec"),
+				"Aggressive NSEC cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.LogVerbosity.IsNull() && !r.LogVerbosity.IsUnknown() {
+		err = adv.SetLogVerbosity(int(r.LogVerbosity.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("log_verbosity"),
+				"Log verbosity cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.EDNSBufferSize.IsNull() && !r.EDNSBufferSize.IsUnknown() {
+		err = adv.SetEDNSBufferSize(int(r.EDNSBufferSize.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("edns_buffer_size"),
+				"EDNS buffer size cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return &adv, diags
+	}
+
+	err = adv.Validate()
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("minimum_ttl"),
+			"Minimum TTL cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &adv, diags
+}
+
+func (r *DNSResolverAdvancedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_advanced", req.ProviderTypeName)
+}
+
+func (r *DNSResolverAdvancedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS resolver advanced settings. Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		MarkdownDescription: "DNS resolver [advanced settings](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-advanced.html). Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"message_cache_size": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Size of the message cache in megabytes, the RRset cache is automatically set to twice this amount, options: '%s'.", joinInts(pfsense.DNSResolverMessageCacheSizes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Size of the message cache in megabytes, the RRset cache is automatically set to twice this amount, options: `%s`.", joinInts(pfsense.DNSResolverMessageCacheSizes, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"infrastructure_cache_hosts": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Number of infrastructure hosts for which information is cached, options: '%s'.", joinInts(pfsense.DNSResolverInfrastructureCacheHosts, "', '")),
+				MarkdownDescription: fmt.Sprintf("Number of infrastructure hosts for which information is cached, options: `%s`.", joinInts(pfsense.DNSResolverInfrastructureCacheHosts, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefetch": schema.BoolAttribute{
+				Description: "Fetch popular cache entries before they expire to keep the cache up to date.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefetch_dns_key": schema.BoolAttribute{
+				Description: "Fetch DNSKEY records earlier in the validation process when a delegation signer is encountered.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serve_expired": schema.BoolAttribute{
+				Description: "Serve expired responses from the cache with a TTL of 0 while refreshing them.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_ttl": schema.Int64Attribute{
+				Description: fmt.Sprintf("Minimum time in seconds that RRsets and messages stay in the cache, between 0 and %d and not greater than the maximum TTL.", pfsense.MaxDNSResolverTTL),
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_ttl": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time in seconds that RRsets and messages stay in the cache, between 0 and %d.", pfsense.MaxDNSResolverTTL),
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"hide_identity": schema.BoolAttribute{
+				Description: "Refuse id.server and hostname.bind queries.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hide_version": schema.BoolAttribute{
+				Description: "Refuse version.server and version.bind queries.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aggressive_nsec": schema.BoolAttribute{
+				Description: "Use the DNSSEC NSEC chain to synthesize NXDOMAIN and other denials from cached data.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_verbosity": schema.Int64Attribute{
+				Description: fmt.Sprintf("Level of detail to be logged, between %d and %d.", pfsense.MinDNSResolverLogVerbosity, pfsense.MaxDNSResolverLogVerbosity),
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"edns_buffer_size": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Number of bytes advertised as the EDNS reassembly buffer size, null when pfSense derives it from interface MTUs, options: '%s'.", joinInts(pfsense.DNSResolverEDNSBufferSizes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Number of bytes advertised as the EDNS reassembly buffer size, null when pfSense derives it from interface MTUs, options: `%s`.", joinInts(pfsense.DNSResolverEDNSBufferSizes, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverAdvancedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverAdvancedResource) update(ctx context.Context, config *DNSResolverAdvancedResourceModel, apply types.Bool) (*DNSResolverAdvancedResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetDNSResolverAdvanced(ctx)
+	if addError(&diags, "Error reading DNS resolver advanced settings", err) {
+		return nil, diags
+	}
+
+	advReq, d := config.Value(ctx, *current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	adv, err := r.client.UpdateDNSResolverAdvanced(ctx, *advReq)
+	if addError(&diags, "Error updating DNS resolver advanced settings", err) {
+		return nil, diags
+	}
+
+	data := DNSResolverAdvancedResourceModel{Apply: apply}
+	diags.Append(data.SetFromValue(ctx, adv)...)
+
+	return &data, diags
+}
+
+func (r *DNSResolverAdvancedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config *DNSResolverAdvancedResourceModel
+	var apply types.Bool
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("apply"), &apply)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config, apply)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err := r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver advanced settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverAdvancedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverAdvancedResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adv, err := r.client.GetDNSResolverAdvanced(ctx)
+	if addError(&resp.Diagnostics, "Error reading DNS resolver advanced settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, adv)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverAdvancedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config *DNSResolverAdvancedResourceModel
+	var apply types.Bool
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("apply"), &apply)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config, apply)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err := r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver advanced settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverAdvancedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts any ID as there is a single set of settings, the following read populates all attributes.
+func (r *DNSResolverAdvancedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("apply"), true)...)
+}
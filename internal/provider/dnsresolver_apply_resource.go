@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +17,7 @@ import (
 )
 
 var _ resource.Resource = &DNSResolverApplyResource{}
+var _ resource.ResourceWithModifyPlan = &DNSResolverApplyResource{}
 
 func NewDNSResolverApplyResource() resource.Resource {
 	return &DNSResolverApplyResource{}
@@ -25,8 +28,10 @@ type DNSResolverApplyResource struct {
 }
 
 type DNSResolverApplyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID             types.String `tfsdk:"id"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+	PendingChanges types.Bool   `tfsdk:"pending_changes"`
+	Triggers       types.Map    `tfsdk:"triggers"`
 }
 
 func (r *DNSResolverApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +40,7 @@ func (r *DNSResolverApplyResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *DNSResolverApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Apply DNS resolver configuration.",
+		Description: "Apply DNS resolver configuration. Fails if the resolver does not come back running with the new configuration. Applies again on the next run if pfSense reports pending changes.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "UUID for DNS resolver apply.",
@@ -51,6 +56,21 @@ func (r *DNSResolverApplyResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"pending_changes": schema.BoolAttribute{
+				Description: "pfSense reports DNS resolver changes which have not been applied, planned as an update which applies them.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will run the apply again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -79,14 +99,21 @@ func (r *DNSResolverApplyResource) Create(ctx context.Context, req resource.Crea
 
 	data.ID = types.StringValue(uuid.New().String())
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	data.PendingChanges = types.BoolValue(false)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DNSResolverApplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readPendingChanges(ctx, resp, "Error reading DNS resolver pending changes", r.client.IsDNSResolverDirty)
+}
+
+func (r *DNSResolverApplyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPendingChangesPlan(ctx, req, resp)
 }
 
 func (r *DNSResolverApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updatePendingChanges(ctx, req, resp, "Error applying DNS resolver changes", r.client.ApplyDNSResolverChanges)
 }
 
 func (r *DNSResolverApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSResolverCacheDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSResolverCacheDataSource{}
+)
+
+func NewDNSResolverCacheDataSource() datasource.DataSource {
+	return &DNSResolverCacheDataSource{}
+}
+
+type DNSResolverCacheDataSource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverCacheDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Records types.List   `tfsdk:"records"`
+}
+
+type DNSResolverCacheRecordModel struct {
+	Name  types.String `tfsdk:"name"`
+	TTL   types.Int64  `tfsdk:"ttl"`
+	Class types.String `tfsdk:"class"`
+	Type  types.String `tfsdk:"type"`
+	Data  types.String `tfsdk:"data"`
+}
+
+func (m DNSResolverCacheRecordModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"ttl":   types.Int64Type,
+		"class": types.StringType,
+		"type":  types.StringType,
+		"data":  types.StringType,
+	}}
+}
+
+func (m *DNSResolverCacheRecordModel) SetFromValue(ctx context.Context, record *pfsense.DNSResolverCacheRecord) diag.Diagnostics {
+	m.Name = types.StringValue(record.Name)
+	m.TTL = types.Int64Value(int64(record.TTL.Seconds()))
+	m.Class = types.StringValue(record.Class)
+	m.Type = types.StringValue(record.Type)
+	m.Data = types.StringValue(record.Data)
+
+	return nil
+}
+
+func (d *DNSResolverCacheDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_cache", req.ProviderTypeName)
+}
+
+func (d *DNSResolverCacheDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a name in the DNS resolver cache, returning the records currently cached for it. Names which are not cached return no records, the lookup does not cause a query.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name to look up, the trailing dot is optional.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return records of this type (for example 'A').",
+				Optional:    true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "Cached records for the name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Owner name of the record.",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Time remaining until the record expires from cache, in seconds.",
+							Computed:    true,
+						},
+						"class": schema.StringAttribute{
+							Description: "Class of the record.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the record.",
+							Computed:    true,
+						},
+						"data": schema.StringAttribute{
+							Description: "Record data in zone file format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSResolverCacheDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSResolverCacheDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSResolverCacheDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.client.LookupDNSResolverCache(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Unable to look up DNS resolver cache", err) {
+		return
+	}
+
+	if !data.Type.IsNull() {
+		filtered := records.GetByType(data.Type.ValueString())
+		records = &filtered
+	}
+
+	recordModels := []DNSResolverCacheRecordModel{}
+	for _, record := range *records {
+		var recordModel DNSResolverCacheRecordModel
+		record := record
+		diags = recordModel.SetFromValue(ctx, &record)
+		resp.Diagnostics.Append(diags...)
+		recordModels = append(recordModels, recordModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Records, diags = types.ListValueFrom(ctx, DNSResolverCacheRecordModel{}.GetAttrType(), recordModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
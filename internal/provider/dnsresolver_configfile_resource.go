@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -71,8 +72,8 @@ func (r *DNSResolverConfigFileResource) Metadata(ctx context.Context, req resour
 
 func (r *DNSResolverConfigFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "DNS resolver (Unbound) config file. Prerequisite: Must add the directive 'include-toplevel: /var/unbound/conf.d/*' to the DNS resolver custom options input. Use with caution, content is not checked/validated.",
-		MarkdownDescription: "DNS resolver (Unbound) [config file](https://man.freebsd.org/cgi/man.cgi?unbound.conf). **Prerequisite**: Must add the directive `include-toplevel: /var/unbound/conf.d/*` to the DNS resolver custom options input. **Use with caution**, content is not checked/validated.",
+		Description:         "DNS resolver (Unbound) config file. Prerequisite: Must add the directive 'include-toplevel: /var/unbound/conf.d/*' to the DNS resolver custom options input. Content is checked with 'unbound-checkconf' before saving, the previous content is restored if applying the change fails.",
+		MarkdownDescription: "DNS resolver (Unbound) [config file](https://man.freebsd.org/cgi/man.cgi?unbound.conf). **Prerequisite**: Must add the directive `include-toplevel: /var/unbound/conf.d/*` to the DNS resolver custom options input. Content is checked with `unbound-checkconf` before saving, the previous content is restored if applying the change fails.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "Name of config file.",
@@ -134,11 +135,13 @@ func (r *DNSResolverConfigFileResource) Create(ctx context.Context, req resource
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-	if data.Apply.ValueBool() {
-		err = r.client.ApplyDNSResolverChanges(ctx)
-		if addError(&resp.Diagnostics, "Error applying config file", err) {
-			return
-		}
+	if !data.Apply.ValueBool() {
+		return
+	}
+
+	// once rolled back the file no longer exists, when the restore failed the file is kept in state.
+	if applied, restored := r.applyOrRollback(ctx, &resp.Diagnostics, configFile.Name, nil); !applied && restored {
+		resp.State.RemoveResource(ctx)
 	}
 }
 
@@ -180,7 +183,9 @@ func (r *DNSResolverConfigFileResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
+	previous, err := r.client.GetDNSResolverConfigFile(ctx, configFileReq.Name)
+	if err != nil && !errors.Is(err, pfsense.ErrNotFound) {
+		addError(&resp.Diagnostics, "Error reading config file", err)
 		return
 	}
 
@@ -197,11 +202,12 @@ func (r *DNSResolverConfigFileResource) Update(ctx context.Context, req resource
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-	if data.Apply.ValueBool() {
-		err = r.client.ApplyDNSResolverChanges(ctx)
-		if addError(&resp.Diagnostics, "Error applying config file", err) {
-			return
-		}
+	if !data.Apply.ValueBool() {
+		return
+	}
+
+	if applied, restored := r.applyOrRollback(ctx, &resp.Diagnostics, configFile.Name, previous); !applied && restored {
+		resp.State.Raw = req.State.Raw
 	}
 }
 
@@ -213,19 +219,49 @@ func (r *DNSResolverConfigFileResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteDNSResolverConfigFile(ctx, data.Name.ValueString())
-	if addError(&resp.Diagnostics, "Error deleting config file", err) {
+	previous, err := r.client.GetDNSResolverConfigFile(ctx, data.Name.ValueString())
+	if err != nil && !errors.Is(err, pfsense.ErrNotFound) {
+		addError(&resp.Diagnostics, "Error reading config file", err)
 		return
 	}
 
-	resp.State.RemoveResource(ctx)
+	err = r.client.DeleteDNSResolverConfigFile(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting config file", err) {
+		return
+	}
 
+	// the file stays in state only when it was restored, a failed restore leaves it deleted.
 	if data.Apply.ValueBool() {
-		err = r.client.ApplyDNSResolverChanges(ctx)
-		if addError(&resp.Diagnostics, "Error applying config file", err) {
+		if applied, restored := r.applyOrRollback(ctx, &resp.Diagnostics, data.Name.ValueString(), previous); !applied && restored {
 			return
 		}
 	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// applyOrRollback applies the change, when that fails the previous content is restored and applied again so a bad file
+// does not leave the resolver down. Returns whether the change was applied and, if not, whether the previous content was
+// restored, the file on the firewall still holds the change when the restore fails.
+func (r *DNSResolverConfigFileResource) applyOrRollback(ctx context.Context, diags *diag.Diagnostics, name string, previous *pfsense.ConfigFile) (bool, bool) {
+	err := r.client.ApplyDNSResolverChanges(ctx)
+	if !addError(diags, "Error applying config file", err) {
+		return true, false
+	}
+
+	err = r.client.RestoreDNSResolverConfigFile(ctx, name, previous)
+	if addError(diags, "Error restoring previous config file", err) {
+		return false, false
+	}
+
+	err = r.client.ApplyDNSResolverChanges(ctx)
+	if addError(diags, "Error applying previous config file", err) {
+		return false, true
+	}
+
+	diags.AddWarning("Config file change rolled back", fmt.Sprintf("Applying config file '%s' failed, the previous content was restored and applied.", name))
+
+	return false, true
 }
 
 func (r *DNSResolverConfigFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +19,7 @@ import (
 
 var _ resource.Resource = &DNSResolverDomainOverrideResource{}
 var _ resource.ResourceWithImportState = &DNSResolverDomainOverrideResource{}
+var _ resource.ResourceWithUpgradeState = &DNSResolverDomainOverrideResource{}
 
 func NewDNSResolverDomainOverrideResource() resource.Resource {
 	return &DNSResolverDomainOverrideResource{}
@@ -28,27 +31,61 @@ type DNSResolverDomainOverrideResource struct {
 
 type DNSResolverDomainOverrideResourceModel struct {
 	Domain      types.String `tfsdk:"domain"`
+	Servers     types.List   `tfsdk:"servers"`
+	Description types.String `tfsdk:"description"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type DNSResolverDomainOverrideServerResourceModel struct {
 	IPAddress   types.String `tfsdk:"ip_address"`
+	Port        types.Int64  `tfsdk:"port"`
 	TLSHostname types.String `tfsdk:"tls_hostname"`
-	Description types.String `tfsdk:"description"`
 	TLSQueries  types.Bool   `tfsdk:"tls_queries"` // unordered to avoid maligned error
-	Apply       types.Bool   `tfsdk:"apply"`
 }
 
-func (r *DNSResolverDomainOverrideResourceModel) SetFromValue(ctx context.Context, domainOverride *pfsense.DomainOverride) diag.Diagnostics {
-	r.Domain = types.StringValue(domainOverride.Domain)
-	r.IPAddress = types.StringValue(domainOverride.IPAddress.String())
-	r.TLSQueries = types.BoolValue(domainOverride.TLSQueries)
+func (r DNSResolverDomainOverrideServerResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"ip_address":   types.StringType,
+		"port":         types.Int64Type,
+		"tls_queries":  types.BoolType,
+		"tls_hostname": types.StringType,
+	}}
+}
 
-	if domainOverride.TLSHostname != "" {
-		r.TLSHostname = types.StringValue(domainOverride.TLSHostname)
+func (r *DNSResolverDomainOverrideServerResourceModel) SetFromValue(ctx context.Context, server *pfsense.DomainOverrideServer) diag.Diagnostics {
+	r.IPAddress = types.StringValue(server.IPAddress.Addr().String())
+	r.Port = types.Int64Value(int64(server.IPAddress.Port()))
+	r.TLSQueries = types.BoolValue(server.TLSQueries)
+
+	if server.TLSHostname != "" {
+		r.TLSHostname = types.StringValue(server.TLSHostname)
 	}
 
+	return nil
+}
+
+func (r *DNSResolverDomainOverrideResourceModel) SetFromValue(ctx context.Context, domainOverride *pfsense.DomainOverride) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Domain = types.StringValue(domainOverride.Domain)
+
 	if domainOverride.Description != "" {
 		r.Description = types.StringValue(domainOverride.Description)
 	}
 
-	return nil
+	servers := []DNSResolverDomainOverrideServerResourceModel{}
+	for _, server := range domainOverride.Servers {
+		var serverModel DNSResolverDomainOverrideServerResourceModel
+		server := server
+		diags.Append(serverModel.SetFromValue(ctx, &server)...)
+		servers = append(servers, serverModel)
+	}
+
+	serversValue, d := types.ListValueFrom(ctx, DNSResolverDomainOverrideServerResourceModel{}.GetAttrType(), servers)
+	diags.Append(d...)
+	r.Servers = serversValue
+
+	return diags
 }
 
 func (r DNSResolverDomainOverrideResourceModel) Value(ctx context.Context) (*pfsense.DomainOverride, diag.Diagnostics) {
@@ -56,6 +93,12 @@ func (r DNSResolverDomainOverrideResourceModel) Value(ctx context.Context) (*pfs
 	var err error
 	var diags diag.Diagnostics
 
+	var serverModels []*DNSResolverDomainOverrideServerResourceModel
+	diags = r.Servers.ElementsAs(ctx, &serverModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	err = domainOverride.SetDomain(r.Domain.ValueString())
 
 	if err != nil {
@@ -66,48 +109,75 @@ func (r DNSResolverDomainOverrideResourceModel) Value(ctx context.Context) (*pfs
 		)
 	}
 
-	err = domainOverride.SetIPAddress(r.IPAddress.ValueString())
+	if !r.Description.IsNull() {
+		err = domainOverride.SetDescription(r.Description.ValueString())
 
-	if err != nil {
-		diags.AddAttributeError(
-			path.Root("ip_address"),
-			"IP address cannot be parsed",
-			err.Error(),
-		)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
 	}
 
-	err = domainOverride.SetTLSQueries(r.TLSQueries.ValueBool())
+	var servers []pfsense.DomainOverrideServer
+	for i, serverModel := range serverModels {
+		var server pfsense.DomainOverrideServer
 
-	if err != nil {
-		diags.AddAttributeError(
-			path.Root("tls_queries"),
-			"TLS Queries cannot be parsed",
-			err.Error(),
-		)
-	}
-
-	if !r.TLSHostname.IsNull() {
-		err = domainOverride.SetTLSHostname(r.TLSHostname.ValueString())
+		err = server.SetTLSQueries(serverModel.TLSQueries.ValueBool())
 
 		if err != nil {
 			diags.AddAttributeError(
-				path.Root("tls_hostname"),
-				"TLS Hostname cannot be parsed",
+				path.Root("servers").AtListIndex(i).AtName("tls_queries"),
+				"TLS Queries cannot be parsed",
 				err.Error(),
 			)
 		}
-	}
 
-	if !r.Description.IsNull() {
-		err = domainOverride.SetDescription(r.Description.ValueString())
+		var port *int
+		if !serverModel.Port.IsNull() && !serverModel.Port.IsUnknown() {
+			p := int(serverModel.Port.ValueInt64())
+			port = &p
+		}
+
+		err = server.SetIPAddress(serverModel.IPAddress.ValueString(), port)
 
 		if err != nil {
 			diags.AddAttributeError(
-				path.Root("description"),
-				"Description cannot be parsed",
+				path.Root("servers").AtListIndex(i).AtName("ip_address"),
+				"IP address cannot be parsed",
 				err.Error(),
 			)
 		}
+
+		if !serverModel.TLSHostname.IsNull() {
+			err = server.SetTLSHostname(serverModel.TLSHostname.ValueString())
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("servers").AtListIndex(i).AtName("tls_hostname"),
+					"TLS Hostname cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		servers = append(servers, server)
+	}
+
+	if diags.HasError() {
+		return &domainOverride, diags
+	}
+
+	err = domainOverride.SetServers(servers)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("servers"),
+			"Servers cannot be parsed",
+			err.Error(),
+		)
 	}
 
 	return &domainOverride, diags
@@ -119,8 +189,9 @@ func (r *DNSResolverDomainOverrideResource) Metadata(ctx context.Context, req re
 
 func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "DNS resolver domain override. Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead.",
-		MarkdownDescription: "DNS resolver [domain override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-domain-overrides.html). Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead.",
+		Version:             1,
+		Description:         "DNS resolver domain override. Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead. Manages all domain override entries of the domain, one per server.",
+		MarkdownDescription: "DNS resolver [domain override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-domain-overrides.html). Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead. Manages all domain override entries of the domain, one per server.",
 		Attributes: map[string]schema.Attribute{
 			"domain": schema.StringAttribute{
 				Description: "Domain whose lookups will be directed to a user-specified DNS lookup server.",
@@ -130,20 +201,34 @@ func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req reso
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"ip_address": schema.StringAttribute{
-				Description: "IPv4 or IPv6 address (including port) of the authoritative DNS server for this domain.",
+			"servers": schema.ListNestedAttribute{
+				Description: "Authoritative DNS servers for this domain, in order.",
 				Required:    true,
-			},
-			"tls_queries": schema.BoolAttribute{
-				Description:         "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to 'false'.",
-				MarkdownDescription: "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to `false`.",
-				Computed:            true,
-				Optional:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-			"tls_hostname": schema.StringAttribute{
-				Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
-				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							Description: "IPv4 or IPv6 address of the server.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description:         fmt.Sprintf("Port of the server, defaults to '%d' or '%d' when TLS queries are enabled.", pfsense.DefaultDNSPort, pfsense.DefaultTLSDNSPort),
+							MarkdownDescription: fmt.Sprintf("Port of the server, defaults to `%d` or `%d` when TLS queries are enabled.", pfsense.DefaultDNSPort, pfsense.DefaultTLSDNSPort),
+							Computed:            true,
+							Optional:            true,
+						},
+						"tls_queries": schema.BoolAttribute{
+							Description:         "Queries to the server will be sent using SSL/TLS, defaults to 'false'.",
+							MarkdownDescription: "Queries to the server will be sent using SSL/TLS, defaults to `false`.",
+							Computed:            true,
+							Optional:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"tls_hostname": schema.StringAttribute{
+							Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
+							Optional:    true,
+						},
+					},
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "For administrative reference (not parsed).",
@@ -160,6 +245,75 @@ func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req reso
 	}
 }
 
+type dnsResolverDomainOverrideResourceModelV0 struct {
+	Domain      types.String `tfsdk:"domain"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	TLSHostname types.String `tfsdk:"tls_hostname"`
+	Description types.String `tfsdk:"description"`
+	TLSQueries  types.Bool   `tfsdk:"tls_queries"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+// UpgradeState migrates version 0 state, a single server per domain with the port included in the IP address.
+func (r *DNSResolverDomainOverrideResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"domain":       schema.StringAttribute{Required: true},
+					"ip_address":   schema.StringAttribute{Required: true},
+					"tls_queries":  schema.BoolAttribute{Computed: true, Optional: true},
+					"tls_hostname": schema.StringAttribute{Optional: true},
+					"description":  schema.StringAttribute{Optional: true},
+					"apply":        schema.BoolAttribute{Computed: true, Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior dnsResolverDomainOverrideResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				addrPort, err := netip.ParseAddrPort(prior.IPAddress.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("ip_address"),
+						"IP address cannot be parsed",
+						err.Error(),
+					)
+
+					return
+				}
+
+				server := DNSResolverDomainOverrideServerResourceModel{
+					IPAddress:   types.StringValue(addrPort.Addr().String()),
+					Port:        types.Int64Value(int64(addrPort.Port())),
+					TLSQueries:  types.BoolValue(prior.TLSQueries.ValueBool()),
+					TLSHostname: prior.TLSHostname,
+				}
+
+				servers, diags := types.ListValueFrom(ctx, DNSResolverDomainOverrideServerResourceModel{}.GetAttrType(), []DNSResolverDomainOverrideServerResourceModel{server})
+				resp.Diagnostics.Append(diags...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := DNSResolverDomainOverrideResourceModel{
+					Domain:      prior.Domain,
+					Servers:     servers,
+					Description: prior.Description,
+					Apply:       prior.Apply,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
 func (r *DNSResolverDomainOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, ok := configureResourceClient(req, resp)
 	if !ok {
@@ -243,10 +397,6 @@ func (r *DNSResolverDomainOverrideResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	domainOverride, err := r.client.UpdateDNSResolverDomainOverride(ctx, *domainOverrideReq)
 	if addError(&resp.Diagnostics, "Error updating domain override", err) {
 		return
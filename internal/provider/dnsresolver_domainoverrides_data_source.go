@@ -31,36 +31,40 @@ type DNSResolverDomainOverridesDataSourceModel struct {
 
 type DNSResolverDomainOverrideDataSourceModel struct {
 	Domain      types.String `tfsdk:"domain"`
-	IPAddress   types.String `tfsdk:"ip_address"`
-	TLSQueries  types.Bool   `tfsdk:"tls_queries"`
-	TLSHostname types.String `tfsdk:"tls_hostname"`
+	Servers     types.List   `tfsdk:"servers"`
 	Description types.String `tfsdk:"description"`
 }
 
 func (d DNSResolverDomainOverrideDataSourceModel) GetAttrType() attr.Type {
 	return types.ObjectType{AttrTypes: map[string]attr.Type{
-		"domain":       types.StringType,
-		"ip_address":   types.StringType,
-		"tls_queries":  types.BoolType,
-		"tls_hostname": types.StringType,
-		"description":  types.StringType,
+		"domain":      types.StringType,
+		"servers":     types.ListType{ElemType: DNSResolverDomainOverrideServerResourceModel{}.GetAttrType()},
+		"description": types.StringType,
 	}}
 }
 
 func (d *DNSResolverDomainOverrideDataSourceModel) SetFromValue(ctx context.Context, domainOverride *pfsense.DomainOverride) diag.Diagnostics {
-	d.Domain = types.StringValue(domainOverride.Domain)
-	d.IPAddress = types.StringValue(domainOverride.IPAddress.String())
-	d.TLSQueries = types.BoolValue(domainOverride.TLSQueries)
+	var diags diag.Diagnostics
 
-	if domainOverride.TLSHostname != "" {
-		d.TLSHostname = types.StringValue(domainOverride.TLSHostname)
-	}
+	d.Domain = types.StringValue(domainOverride.Domain)
 
 	if domainOverride.Description != "" {
 		d.Description = types.StringValue(domainOverride.Description)
 	}
 
-	return nil
+	servers := []DNSResolverDomainOverrideServerResourceModel{}
+	for _, server := range domainOverride.Servers {
+		var serverModel DNSResolverDomainOverrideServerResourceModel
+		server := server
+		diags.Append(serverModel.SetFromValue(ctx, &server)...)
+		servers = append(servers, serverModel)
+	}
+
+	serversValue, dd := types.ListValueFrom(ctx, DNSResolverDomainOverrideServerResourceModel{}.GetAttrType(), servers)
+	diags.Append(dd...)
+	d.Servers = serversValue
+
+	return diags
 }
 
 func (d *DNSResolverDomainOverridesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -81,18 +85,29 @@ func (d *DNSResolverDomainOverridesDataSource) Schema(_ context.Context, _ datas
 							Description: "Domain whose lookups will be directed to a user-specified DNS lookup server.",
 							Computed:    true,
 						},
-						"ip_address": schema.StringAttribute{
-							Description: "IPv4 or IPv6 address (including port) of the authoritative DNS server for this domain.",
-							Computed:    true,
-						},
-						"tls_queries": schema.BoolAttribute{
-							Description:         "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to 'false'.",
-							MarkdownDescription: "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to `false`.",
-							Computed:            true,
-						},
-						"tls_hostname": schema.StringAttribute{
-							Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
+						"servers": schema.ListNestedAttribute{
+							Description: "Authoritative DNS servers for this domain, in order.",
 							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"ip_address": schema.StringAttribute{
+										Description: "IPv4 or IPv6 address of the server.",
+										Computed:    true,
+									},
+									"port": schema.Int64Attribute{
+										Description: "Port of the server.",
+										Computed:    true,
+									},
+									"tls_queries": schema.BoolAttribute{
+										Description: "Queries to the server will be sent using SSL/TLS.",
+										Computed:    true,
+									},
+									"tls_hostname": schema.StringAttribute{
+										Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
+										Computed:    true,
+									},
+								},
+							},
 						},
 						"description": schema.StringAttribute{
 							Description: "For administrative reference (not parsed).",
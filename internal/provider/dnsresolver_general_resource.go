@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverGeneralResource{}
+
+func NewDNSResolverGeneralResource() resource.Resource {
+	return &DNSResolverGeneralResource{}
+}
+
+type DNSResolverGeneralResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverGeneralResourceModel struct {
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	ListenInterfaces           types.List   `tfsdk:"listen_interfaces"`
+	OutgoingInterfaces         types.List   `tfsdk:"outgoing_interfaces"`
+	SystemDomainLocalZoneType  types.String `tfsdk:"system_domain_local_zone_type"`
+	DNSSEC                     types.Bool   `tfsdk:"dnssec"`
+	Forwarding                 types.Bool   `tfsdk:"forwarding"`
+	RegisterDHCPLeases         types.Bool   `tfsdk:"register_dhcp_leases"`
+	RegisterDHCPStaticMappings types.Bool   `tfsdk:"register_dhcp_static_mappings"`
+	RegisterOpenVPNClients     types.Bool   `tfsdk:"register_openvpn_clients"`
+	CustomOptions              types.String `tfsdk:"custom_options"`
+	Apply                      types.Bool   `tfsdk:"apply"`
+}
+
+func (r *DNSResolverGeneralResourceModel) SetFromValue(ctx context.Context, gen *pfsense.DNSResolverGeneral) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	r.Enabled = types.BoolValue(gen.Enabled)
+
+	r.ListenInterfaces, d = types.ListValueFrom(ctx, types.StringType, gen.ListenInterfaces)
+	diags.Append(d...)
+
+	r.OutgoingInterfaces, d = types.ListValueFrom(ctx, types.StringType, gen.OutgoingInterfaces)
+	diags.Append(d...)
+
+	r.SystemDomainLocalZoneType = types.StringValue(gen.SystemDomainLocalZoneType)
+	r.DNSSEC = types.BoolValue(gen.DNSSEC)
+	r.Forwarding = types.BoolValue(gen.Forwarding)
+	r.RegisterDHCPLeases = types.BoolValue(gen.RegisterDHCPLeases)
+	r.RegisterDHCPStaticMappings = types.BoolValue(gen.RegisterDHCPStaticMappings)
+	r.RegisterOpenVPNClients = types.BoolValue(gen.RegisterOpenVPNClients)
+	r.CustomOptions = types.StringValue(gen.CustomOptions)
+
+	return diags
+}
+
+// Value overlays the configured general settings on those read from pfSense, so a partial configuration is not reset.
+func (r DNSResolverGeneralResourceModel) Value(ctx context.Context, current pfsense.DNSResolverGeneral) (*pfsense.DNSResolverGeneral, diag.Diagnostics) {
+	gen := current
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.Enabled.IsNull() && !r.Enabled.IsUnknown() {
+		err = gen.SetEnabled(r.Enabled.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("enabled"),
+				"Enabled cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.ListenInterfaces.IsNull() && !r.ListenInterfaces.IsUnknown() {
+		var interfaces []string
+		diags.Append(r.ListenInterfaces.ElementsAs(ctx, &interfaces, false)...)
+
+		err = gen.SetListenInterfaces(interfaces)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("listen_interfaces"),
+				"Listen interfaces cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.OutgoingInterfaces.IsNull() && !r.OutgoingInterfaces.IsUnknown() {
+		var interfaces []string
+		diags.Append(r.OutgoingInterfaces.ElementsAs(ctx, &interfaces, false)...)
+
+		err = gen.SetOutgoingInterfaces(interfaces)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("outgoing_interfaces"),
+				"Outgoing interfaces cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.SystemDomainLocalZoneType.IsNull() && !r.SystemDomainLocalZoneType.IsUnknown() {
+		err = gen.SetSystemDomainLocalZoneType(r.SystemDomainLocalZoneType.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("system_domain_local_zone_type"),
+				"System domain local zone type cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.DNSSEC.IsNull() && !r.DNSSEC.IsUnknown() {
+		err = gen.SetDNSSEC(r.DNSSEC.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("dnssec"),
+				"DNSSEC cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Forwarding.IsNull() && !r.Forwarding.IsUnknown() {
+		err = gen.SetForwarding(r.Forwarding.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("forwarding"),
+				"Forwarding cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.RegisterDHCPLeases.IsNull() && !r.RegisterDHCPLeases.IsUnknown() {
+		err = gen.SetRegisterDHCPLeases(r.RegisterDHCPLeases.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("register_dhcp_leases"),
+				"Register DHCP leases cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.RegisterDHCPStaticMappings.IsNull() && !r.RegisterDHCPStaticMappings.IsUnknown() {
+		err = gen.SetRegisterDHCPStaticMappings(r.RegisterDHCPStaticMappings.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("register_dhcp_static_mappings"),
+				"Register DHCP static mappings cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.RegisterOpenVPNClients.IsNull() && !r.RegisterOpenVPNClients.IsUnknown() {
+		err = gen.SetRegisterOpenVPNClients(r.RegisterOpenVPNClients.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("register_openvpn_clients"),
+				"Register OpenVPN clients cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.CustomOptions.IsNull() && !r.CustomOptions.IsUnknown() {
+		err = gen.SetCustomOptions(r.CustomOptions.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("custom_options"),
+				"Custom options cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &gen, diags
+}
+
+func (r *DNSResolverGeneralResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_general", req.ProviderTypeName)
+}
+
+func (r *DNSResolverGeneralResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS resolver general settings. Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		MarkdownDescription: "DNS resolver [general settings](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-config.html). Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the DNS resolver.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"listen_interfaces": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         fmt.Sprintf("Interfaces used for responding to queries from clients, for example 'lan' or 'lo0'. Use '%s' alone to listen on all interfaces.", pfsense.DNSResolverInterfaceAll),
+				MarkdownDescription: fmt.Sprintf("Interfaces used for responding to queries from clients, for example `lan` or `lo0`. Use `%s` alone to listen on all interfaces.", pfsense.DNSResolverInterfaceAll),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outgoing_interfaces": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         fmt.Sprintf("Interfaces used to send queries to authoritative servers and receive their replies, for example 'wan'. Use '%s' alone to use all interfaces.", pfsense.DNSResolverInterfaceAll),
+				MarkdownDescription: fmt.Sprintf("Interfaces used to send queries to authoritative servers and receive their replies, for example `wan`. Use `%s` alone to use all interfaces.", pfsense.DNSResolverInterfaceAll),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_domain_local_zone_type": schema.StringAttribute{
+				Description:         fmt.Sprintf("Local zone type used for the system domain, options: '%s'.", strings.Join(pfsense.LocalZoneTypes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Local zone type used for the system domain, options: `%s`.", strings.Join(pfsense.LocalZoneTypes, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec": schema.BoolAttribute{
+				Description: "Enable DNSSEC support.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"forwarding": schema.BoolAttribute{
+				Description: "Forward queries to the upstream DNS servers configured in the system general settings instead of resolving them directly.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"register_dhcp_leases": schema.BoolAttribute{
+				Description: "Register DHCP leases so that machines which specify a hostname when requesting a lease can be resolved.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"register_dhcp_static_mappings": schema.BoolAttribute{
+				Description: "Register DHCP static mappings so that their hostnames can be resolved.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"register_openvpn_clients": schema.BoolAttribute{
+				Description: "Register connected OpenVPN clients so that their common names can be resolved.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_options": schema.StringAttribute{
+				Description: "Additional configuration parameters added to the server section of the resolver configuration.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverGeneralResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverGeneralResource) update(ctx context.Context, config *DNSResolverGeneralResourceModel, apply types.Bool) (*DNSResolverGeneralResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetDNSResolverGeneral(ctx)
+	if addError(&diags, "Error reading DNS resolver general settings", err) {
+		return nil, diags
+	}
+
+	genReq, d := config.Value(ctx, *current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	gen, err := r.client.UpdateDNSResolverGeneral(ctx, *genReq)
+	if addError(&diags, "Error updating DNS resolver general settings", err) {
+		return nil, diags
+	}
+
+	data := DNSResolverGeneralResourceModel{Apply: apply}
+	diags.Append(data.SetFromValue(ctx, gen)...)
+
+	return &data, diags
+}
+
+func (r *DNSResolverGeneralResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config *DNSResolverGeneralResourceModel
+	var apply types.Bool
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("apply"), &apply)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config, apply)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err := r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver general settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverGeneralResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverGeneralResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gen, err := r.client.GetDNSResolverGeneral(ctx)
+	if addError(&resp.Diagnostics, "Error reading DNS resolver general settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, gen)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverGeneralResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config *DNSResolverGeneralResourceModel
+	var apply types.Bool
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("apply"), &apply)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config, apply)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err := r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver general settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverGeneralResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
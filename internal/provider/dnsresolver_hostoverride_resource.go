@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -12,14 +13,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
 )
 
 var _ resource.Resource = &DNSResolverHostOverrideResource{}
 var _ resource.ResourceWithImportState = &DNSResolverHostOverrideResource{}
+var _ resource.ResourceWithModifyPlan = &DNSResolverHostOverrideResource{}
+
+const dnsResolverHostOverrideFingerprintKey = "fingerprint"
+
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func setDNSResolverHostOverrideFingerprint(ctx context.Context, private privateState, hostOverride *pfsense.HostOverride) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fingerprint, err := hostOverride.Fingerprint()
+	if addError(&diags, "Error fingerprinting host override", err) {
+		return diags
+	}
+
+	b, err := json.Marshal(fingerprint)
+	if addError(&diags, "Error fingerprinting host override", err) {
+		return diags
+	}
+
+	return private.SetKey(ctx, dnsResolverHostOverrideFingerprintKey, b)
+}
+
+func getDNSResolverHostOverrideFingerprint(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	var fingerprint string
+
+	b, diags := private.GetKey(ctx, dnsResolverHostOverrideFingerprintKey)
+	if diags.HasError() || b == nil {
+		return fingerprint, diags
+	}
+
+	err := json.Unmarshal(b, &fingerprint)
+	addError(&diags, "Error fingerprinting host override", err)
+
+	return fingerprint, diags
+}
 
 func NewDNSResolverHostOverrideResource() resource.Resource {
 	return &DNSResolverHostOverrideResource{}
@@ -199,22 +236,16 @@ func (r *DNSResolverHostOverrideResource) Metadata(ctx context.Context, req reso
 
 func (r *DNSResolverHostOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "DNS resolver host override. Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver.",
-		MarkdownDescription: "DNS resolver [host override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-host-overrides.html). Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver.",
+		Description:         "DNS resolver host override. Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver. Host overrides are identified by FQDN, which must be unique, changing the host or domain renames the entry in place.",
+		MarkdownDescription: "DNS resolver [host override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-host-overrides.html). Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver. Host overrides are identified by FQDN, which must be unique, changing the host or domain renames the entry in place.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Description: "Name of the host, without the domain part.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"domain": schema.StringAttribute{
 				Description: "Parent domain of the host.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"ip_addresses": schema.ListAttribute{
 				ElementType: types.StringType,
@@ -235,9 +266,6 @@ func (r *DNSResolverHostOverrideResource) Schema(ctx context.Context, req resour
 			"fqdn": schema.StringAttribute{
 				Description: "Fully qualified domain name of host.",
 				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			"aliases": schema.ListNestedAttribute{
 				Description:         "List of additional names for this host, defaults to '[]'.",
@@ -297,6 +325,7 @@ func (r *DNSResolverHostOverrideResource) Create(ctx context.Context, req resour
 
 	diags = data.SetFromValue(ctx, hostOverride)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setDNSResolverHostOverrideFingerprint(ctx, resp.Private, hostOverride)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -327,6 +356,7 @@ func (r *DNSResolverHostOverrideResource) Read(ctx context.Context, req resource
 
 	diags = data.SetFromValue(ctx, hostOverride)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setDNSResolverHostOverrideFingerprint(ctx, resp.Private, hostOverride)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -349,17 +379,26 @@ func (r *DNSResolverHostOverrideResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	var fqdn types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fqdn"), &fqdn)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fingerprint, diags := getDNSResolverHostOverrideFingerprint(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	hostOverride, err := r.client.UpdateDNSResolverHostOverride(ctx, *hostOverrideReq)
+	hostOverride, err := r.client.UpdateDNSResolverHostOverride(ctx, fqdn.ValueString(), fingerprint, *hostOverrideReq)
 	if addError(&resp.Diagnostics, "Error updating host override", err) {
 		return
 	}
 
 	diags = data.SetFromValue(ctx, hostOverride)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setDNSResolverHostOverrideFingerprint(ctx, resp.Private, hostOverride)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -382,7 +421,13 @@ func (r *DNSResolverHostOverrideResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	err := r.client.DeleteDNSResolverHostOverride(ctx, data.FQDN.ValueString())
+	fingerprint, diags := getDNSResolverHostOverrideFingerprint(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverHostOverride(ctx, data.FQDN.ValueString(), fingerprint)
 	if addError(&resp.Diagnostics, "Error deleting host override", err) {
 		return
 	}
@@ -397,13 +442,36 @@ func (r *DNSResolverHostOverrideResource) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *DNSResolverHostOverrideResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *DNSResolverHostOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Host.IsUnknown() || data.Domain.IsUnknown() {
+		return
+	}
+
+	// fqdn follows host and domain, known at plan time so a rename is shown as an in-place update.
+	ho := pfsense.HostOverride{Host: data.Host.ValueString(), Domain: data.Domain.ValueString()}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fqdn"), ho.FQDN())...)
+}
+
 func (r *DNSResolverHostOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// a plain FQDN is also accepted, host and domain are then split by the existing entry during read.
+	if req.ID != "" && !strings.Contains(req.ID, ",") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), req.ID)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 
 	if len(idParts) != 2 || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: host,domain. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: host,domain or fqdn. Got: %q", req.ID),
 		)
 		return
 	}
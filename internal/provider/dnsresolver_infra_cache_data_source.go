@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSResolverInfraCacheDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSResolverInfraCacheDataSource{}
+)
+
+func NewDNSResolverInfraCacheDataSource() datasource.DataSource {
+	return &DNSResolverInfraCacheDataSource{}
+}
+
+type DNSResolverInfraCacheDataSource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverInfraCacheDataSourceModel struct {
+	Zone types.String `tfsdk:"zone"`
+	All  types.List   `tfsdk:"all"`
+}
+
+type DNSResolverInfraCacheEntryModel struct {
+	Address       types.String `tfsdk:"address"`
+	Zone          types.String `tfsdk:"zone"`
+	Expired       types.Bool   `tfsdk:"expired"`
+	TTL           types.Int64  `tfsdk:"ttl"`
+	Ping          types.Int64  `tfsdk:"ping"`
+	Variance      types.Int64  `tfsdk:"variance"`
+	RTT           types.Int64  `tfsdk:"rtt"`
+	RTO           types.Int64  `tfsdk:"rto"`
+	TimeoutsA     types.Int64  `tfsdk:"timeouts_a"`
+	TimeoutsAAAA  types.Int64  `tfsdk:"timeouts_aaaa"`
+	TimeoutsOther types.Int64  `tfsdk:"timeouts_other"`
+	EDNSKnown     types.Bool   `tfsdk:"edns_known"`
+	EDNSVersion   types.Int64  `tfsdk:"edns_version"`
+	Lame          types.Bool   `tfsdk:"lame"`
+}
+
+func (m DNSResolverInfraCacheEntryModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"address":        types.StringType,
+		"zone":           types.StringType,
+		"expired":        types.BoolType,
+		"ttl":            types.Int64Type,
+		"ping":           types.Int64Type,
+		"variance":       types.Int64Type,
+		"rtt":            types.Int64Type,
+		"rto":            types.Int64Type,
+		"timeouts_a":     types.Int64Type,
+		"timeouts_aaaa":  types.Int64Type,
+		"timeouts_other": types.Int64Type,
+		"edns_known":     types.BoolType,
+		"edns_version":   types.Int64Type,
+		"lame":           types.BoolType,
+	}}
+}
+
+func (m *DNSResolverInfraCacheEntryModel) SetFromValue(ctx context.Context, entry *pfsense.DNSResolverInfraCacheEntry) diag.Diagnostics {
+	m.Address = types.StringValue(entry.Address.String())
+	m.Zone = types.StringValue(entry.Zone)
+	m.Expired = types.BoolValue(entry.Expired)
+	m.TTL = types.Int64Value(int64(entry.TTL.Seconds()))
+	m.Ping = types.Int64Value(entry.Ping.Milliseconds())
+	m.Variance = types.Int64Value(entry.Variance.Milliseconds())
+	m.RTT = types.Int64Value(entry.RTT.Milliseconds())
+	m.RTO = types.Int64Value(entry.RTO.Milliseconds())
+	m.TimeoutsA = types.Int64Value(int64(entry.TimeoutsA))
+	m.TimeoutsAAAA = types.Int64Value(int64(entry.TimeoutsAAAA))
+	m.TimeoutsOther = types.Int64Value(int64(entry.TimeoutsOther))
+	m.EDNSKnown = types.BoolValue(entry.EDNSKnown)
+	m.EDNSVersion = types.Int64Value(int64(entry.EDNSVersion))
+	m.Lame = types.BoolValue(entry.Lame)
+
+	return nil
+}
+
+func (d *DNSResolverInfraCacheDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_infra_cache", req.ProviderTypeName)
+}
+
+func (d *DNSResolverInfraCacheDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the DNS resolver infrastructure cache from 'unbound-control dump_infra', the round trip times, timeouts, and EDNS support the resolver has recorded for upstream servers.",
+		MarkdownDescription: "Retrieves the DNS resolver infrastructure cache from [`unbound-control dump_infra`](https://unbound.docs.nlnetlabs.nl/en/latest/manpages/unbound-control.html), the round trip times, timeouts, and EDNS support the resolver has recorded for upstream servers.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Description: "Only return entries for this zone, with trailing dot (for example 'example.com.').",
+				Optional:    true,
+			},
+			"all": schema.ListNestedAttribute{
+				Description: "All matching entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "IP address of the upstream server.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "Zone the server was used for.",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Entry is past its TTL, only the retransmit timeout is reported.",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Time until the entry expires, in seconds.",
+							Computed:    true,
+						},
+						"ping": schema.Int64Attribute{
+							Description: "Smoothed round trip time, in milliseconds.",
+							Computed:    true,
+						},
+						"variance": schema.Int64Attribute{
+							Description: "Round trip time variance, in milliseconds.",
+							Computed:    true,
+						},
+						"rtt": schema.Int64Attribute{
+							Description: "Round trip time estimate, in milliseconds.",
+							Computed:    true,
+						},
+						"rto": schema.Int64Attribute{
+							Description: "Retransmit timeout, in milliseconds.",
+							Computed:    true,
+						},
+						"timeouts_a": schema.Int64Attribute{
+							Description: "Number of timeouts for A queries.",
+							Computed:    true,
+						},
+						"timeouts_aaaa": schema.Int64Attribute{
+							Description: "Number of timeouts for AAAA queries.",
+							Computed:    true,
+						},
+						"timeouts_other": schema.Int64Attribute{
+							Description: "Number of timeouts for other queries.",
+							Computed:    true,
+						},
+						"edns_known": schema.BoolAttribute{
+							Description: "EDNS support of the server has been probed.",
+							Computed:    true,
+						},
+						"edns_version": schema.Int64Attribute{
+							Description: "EDNS version supported by the server, -1 if EDNS is not supported.",
+							Computed:    true,
+						},
+						"lame": schema.BoolAttribute{
+							Description: "Server gave lame (non-authoritative or unusable) answers for the zone.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSResolverInfraCacheDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSResolverInfraCacheDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSResolverInfraCacheDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cache, err := d.client.GetDNSResolverInfraCache(ctx)
+	if addError(&resp.Diagnostics, "Unable to get DNS resolver infra cache", err) {
+		return
+	}
+
+	if !data.Zone.IsNull() {
+		filtered := cache.GetByZone(data.Zone.ValueString())
+		cache = &filtered
+	}
+
+	entryModels := []DNSResolverInfraCacheEntryModel{}
+	for _, entry := range *cache {
+		var entryModel DNSResolverInfraCacheEntryModel
+		entry := entry
+		diags = entryModel.SetFromValue(ctx, &entry)
+		resp.Diagnostics.Append(diags...)
+		entryModels = append(entryModels, entryModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, DNSResolverInfraCacheEntryModel{}.GetAttrType(), entryModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
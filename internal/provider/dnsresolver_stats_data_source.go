@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSResolverStatsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSResolverStatsDataSource{}
+)
+
+func NewDNSResolverStatsDataSource() datasource.DataSource {
+	return &DNSResolverStatsDataSource{}
+}
+
+type DNSResolverStatsDataSource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverStatsDataSourceModel struct {
+	Queries              types.Int64   `tfsdk:"queries"`
+	CacheHits            types.Int64   `tfsdk:"cache_hits"`
+	CacheMisses          types.Int64   `tfsdk:"cache_misses"`
+	CacheHitRatio        types.Float64 `tfsdk:"cache_hit_ratio"`
+	Prefetches           types.Int64   `tfsdk:"prefetches"`
+	ExpiredReplies       types.Int64   `tfsdk:"expired_replies"`
+	RecursiveReplies     types.Int64   `tfsdk:"recursive_replies"`
+	RequestListAverage   types.Float64 `tfsdk:"request_list_average"`
+	RequestListMax       types.Int64   `tfsdk:"request_list_max"`
+	RecursionTimeAverage types.Float64 `tfsdk:"recursion_time_average"`
+	RecursionTimeMedian  types.Float64 `tfsdk:"recursion_time_median"`
+	Uptime               types.Int64   `tfsdk:"uptime"`
+	QueryTypes           types.Map     `tfsdk:"query_types"`
+	AnswerRcodes         types.Map     `tfsdk:"answer_rcodes"`
+	Values               types.Map     `tfsdk:"values"`
+}
+
+func (m *DNSResolverStatsDataSourceModel) SetFromValue(ctx context.Context, stats *pfsense.DNSResolverStats) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Queries = types.Int64Value(int64(stats.Queries))
+	m.CacheHits = types.Int64Value(int64(stats.CacheHits))
+	m.CacheMisses = types.Int64Value(int64(stats.CacheMisses))
+	m.CacheHitRatio = types.Float64Value(stats.CacheHitRatio())
+	m.Prefetches = types.Int64Value(int64(stats.Prefetches))
+	m.ExpiredReplies = types.Int64Value(int64(stats.ExpiredReplies))
+	m.RecursiveReplies = types.Int64Value(int64(stats.RecursiveReplies))
+	m.RequestListAverage = types.Float64Value(stats.RequestListAverage)
+	m.RequestListMax = types.Int64Value(int64(stats.RequestListMax))
+	m.RecursionTimeAverage = types.Float64Value(stats.RecursionTimeAverage.Seconds())
+	m.RecursionTimeMedian = types.Float64Value(stats.RecursionTimeMedian.Seconds())
+	m.Uptime = types.Int64Value(int64(stats.Uptime.Seconds()))
+
+	queryTypes := map[string]int64{}
+	for name, count := range stats.QueryTypes {
+		queryTypes[name] = int64(count)
+	}
+
+	answerRcodes := map[string]int64{}
+	for name, count := range stats.AnswerRcodes {
+		answerRcodes[name] = int64(count)
+	}
+
+	m.QueryTypes, d = types.MapValueFrom(ctx, types.Int64Type, queryTypes)
+	diags.Append(d...)
+
+	m.AnswerRcodes, d = types.MapValueFrom(ctx, types.Int64Type, answerRcodes)
+	diags.Append(d...)
+
+	m.Values, d = types.MapValueFrom(ctx, types.StringType, stats.Values)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (d *DNSResolverStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_stats", req.ProviderTypeName)
+}
+
+func (d *DNSResolverStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves DNS resolver statistics from 'unbound-control stats_noreset'. Counters are totals across threads since the resolver was last started, reading them does not reset them.",
+		MarkdownDescription: "Retrieves DNS resolver statistics from [`unbound-control stats_noreset`](https://unbound.docs.nlnetlabs.nl/en/latest/manpages/unbound-control.html). Counters are totals across threads since the resolver was last started, reading them does not reset them.",
+		Attributes: map[string]schema.Attribute{
+			"queries": schema.Int64Attribute{
+				Description: "Number of queries received by the resolver.",
+				Computed:    true,
+			},
+			"cache_hits": schema.Int64Attribute{
+				Description: "Number of queries answered from cache.",
+				Computed:    true,
+			},
+			"cache_misses": schema.Int64Attribute{
+				Description: "Number of queries which needed recursive processing.",
+				Computed:    true,
+			},
+			"cache_hit_ratio": schema.Float64Attribute{
+				Description: "Share of queries answered from cache, between 0 and 1.",
+				Computed:    true,
+			},
+			"prefetches": schema.Int64Attribute{
+				Description: "Number of cache prefetches performed.",
+				Computed:    true,
+			},
+			"expired_replies": schema.Int64Attribute{
+				Description: "Number of replies served from expired cache entries.",
+				Computed:    true,
+			},
+			"recursive_replies": schema.Int64Attribute{
+				Description: "Number of replies sent to queries which needed recursive processing.",
+				Computed:    true,
+			},
+			"request_list_average": schema.Float64Attribute{
+				Description: "Average number of queries waiting for recursive replies.",
+				Computed:    true,
+			},
+			"request_list_max": schema.Int64Attribute{
+				Description: "Maximum number of queries waiting for recursive replies.",
+				Computed:    true,
+			},
+			"recursion_time_average": schema.Float64Attribute{
+				Description: "Average time to answer queries which needed recursive processing, in seconds.",
+				Computed:    true,
+			},
+			"recursion_time_median": schema.Float64Attribute{
+				Description: "Median time to answer queries which needed recursive processing, in seconds.",
+				Computed:    true,
+			},
+			"uptime": schema.Int64Attribute{
+				Description: "Time since the resolver was started, in seconds.",
+				Computed:    true,
+			},
+			"query_types": schema.MapAttribute{
+				Description: "Number of queries by record type.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"answer_rcodes": schema.MapAttribute{
+				Description: "Number of answers by response code.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"values": schema.MapAttribute{
+				Description: "All statistics as reported, including per thread and memory statistics.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DNSResolverStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSResolverStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSResolverStatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := d.client.GetDNSResolverStats(ctx)
+	if addError(&resp.Diagnostics, "Unable to get DNS resolver stats", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, stats)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallAdvancedResource{}
+
+func NewFirewallAdvancedResource() resource.Resource {
+	return &FirewallAdvancedResource{}
+}
+
+type FirewallAdvancedResource struct {
+	client *pfsense.Client
+}
+
+type FirewallAdvancedResourceModel struct {
+	OptimizationMode               types.String `tfsdk:"optimization_mode"`
+	MaximumStates                  types.Int64  `tfsdk:"maximum_states"`
+	MaximumTableEntries            types.Int64  `tfsdk:"maximum_table_entries"`
+	BogonsUpdateFrequency          types.String `tfsdk:"bogons_update_frequency"`
+	NATReflectionMode              types.String `tfsdk:"nat_reflection_mode"`
+	NATReflectionTimeout           types.Int64  `tfsdk:"nat_reflection_timeout"`
+	OneToOneNATReflection          types.Bool   `tfsdk:"one_to_one_nat_reflection"`
+	AutomaticOutboundNATReflection types.Bool   `tfsdk:"automatic_outbound_nat_reflection"`
+	StaticRouteFiltering           types.Bool   `tfsdk:"static_route_filtering"`
+	DisableReplyTo                 types.Bool   `tfsdk:"disable_reply_to"`
+}
+
+func (r *FirewallAdvancedResourceModel) SetFromValue(ctx context.Context, adv *pfsense.FirewallAdvanced) diag.Diagnostics {
+	r.OptimizationMode = types.StringValue(adv.OptimizationMode)
+	r.MaximumStates = optionalInt64Value(adv.MaximumStates)
+	r.MaximumTableEntries = optionalInt64Value(adv.MaximumTableEntries)
+	r.BogonsUpdateFrequency = types.StringValue(adv.BogonsUpdateFrequency)
+	r.NATReflectionMode = types.StringValue(adv.NATReflectionMode)
+	r.NATReflectionTimeout = optionalInt64Value(adv.NATReflectionTimeout)
+	r.OneToOneNATReflection = types.BoolValue(adv.OneToOneNATReflection)
+	r.AutomaticOutboundNATReflection = types.BoolValue(adv.AutomaticOutboundNATReflection)
+	r.StaticRouteFiltering = types.BoolValue(adv.StaticRouteFiltering)
+	r.DisableReplyTo = types.BoolValue(adv.DisableReplyTo)
+
+	return nil
+}
+
+// Value applies the configured attributes to the current firewall advanced settings, unset attributes keep their current value.
+func (r FirewallAdvancedResourceModel) Value(ctx context.Context, current pfsense.FirewallAdvanced) (*pfsense.FirewallAdvanced, diag.Diagnostics) {
+	adv := current
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.OptimizationMode.IsNull() && !r.OptimizationMode.IsUnknown() {
+		err = adv.SetOptimizationMode(r.OptimizationMode.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("optimization_mode"),
+				"Optimization mode cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.MaximumStates.IsNull() && !r.MaximumStates.IsUnknown() {
+		err = adv.SetMaximumStates(int(r.MaximumStates.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("maximum_states"),
+				"Maximum states cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.MaximumTableEntries.IsNull() && !r.MaximumTableEntries.IsUnknown() {
+		err = adv.SetMaximumTableEntries(int(r.MaximumTableEntries.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("maximum_table_entries"),
+				"Maximum table entries cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.BogonsUpdateFrequency.IsNull() && !r.BogonsUpdateFrequency.IsUnknown() {
+		err = adv.SetBogonsUpdateFrequency(r.BogonsUpdateFrequency.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("bogons_update_frequency"),
+				"Bogons update frequency cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.NATReflectionMode.IsNull() && !r.NATReflectionMode.IsUnknown() {
+		err = adv.SetNATReflectionMode(r.NATReflectionMode.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("nat_reflection_mode"),
+				"NAT reflection mode cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.NATReflectionTimeout.IsNull() && !r.NATReflectionTimeout.IsUnknown() {
+		err = adv.SetNATReflectionTimeout(int(r.NATReflectionTimeout.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("nat_reflection_timeout"),
+				"NAT reflection timeout cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.OneToOneNATReflection.IsNull() && !r.OneToOneNATReflection.IsUnknown() {
+		err = adv.SetOneToOneNATReflection(r.OneToOneNATReflection.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("one_to_one_nat_reflection"),
+				"1:1 NAT reflection cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.AutomaticOutboundNATReflection.IsNull() && !r.AutomaticOutboundNATReflection.IsUnknown() {
+		err = adv.SetAutomaticOutboundNATReflection(r.AutomaticOutboundNATReflection.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("automatic_outbound_nat_reflection"),
+				"Automatic outbound NAT reflection cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.StaticRouteFiltering.IsNull() && !r.StaticRouteFiltering.IsUnknown() {
+		err = adv.SetStaticRouteFiltering(r.StaticRouteFiltering.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("static_route_filtering"),
+				"Static route filtering cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.DisableReplyTo.IsNull() && !r.DisableReplyTo.IsUnknown() {
+		err = adv.SetDisableReplyTo(r.DisableReplyTo.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("disable_reply_to"),
+				"Disable reply-to cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &adv, diags
+}
+
+func (r *FirewallAdvancedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_advanced", req.ProviderTypeName)
+}
+
+func (r *FirewallAdvancedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall and NAT advanced settings. Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		MarkdownDescription: "Firewall and NAT [advanced settings](https://docs.netgate.com/pfsense/en/latest/config/advanced-firewall-nat.html). Only attributes set in configuration are modified, others are read from pfSense. Only one instance of this resource should exist, destroying it leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"optimization_mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("State table optimization mode, options: '%s'.", strings.Join(pfsense.FirewallOptimizationModes, "', '")),
+				MarkdownDescription: fmt.Sprintf("State table optimization mode, options: `%s`.", strings.Join(pfsense.FirewallOptimizationModes, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_states": schema.Int64Attribute{
+				Description: "Maximum number of connections to hold in the state table, null when pfSense uses its default based on memory.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_table_entries": schema.Int64Attribute{
+				Description: "Maximum number of table entries for systems such as aliases, sshguard, and snort, null when pfSense uses its default.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bogons_update_frequency": schema.StringAttribute{
+				Description:         fmt.Sprintf("Frequency of bogon network list updates, options: '%s'.", strings.Join(pfsense.BogonsUpdateFrequencies, "', '")),
+				MarkdownDescription: fmt.Sprintf("Frequency of bogon network list updates, options: `%s`.", strings.Join(pfsense.BogonsUpdateFrequencies, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nat_reflection_mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("Default NAT reflection mode for port forwards, options: '%s'.", strings.Join(pfsense.NATReflectionModes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Default NAT reflection mode for port forwards, options: `%s`.", strings.Join(pfsense.NATReflectionModes, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nat_reflection_timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds for NAT reflection proxy connections, null when pfSense uses its default.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"one_to_one_nat_reflection": schema.BoolAttribute{
+				Description: "Enable NAT reflection for 1:1 NAT mappings by default.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"automatic_outbound_nat_reflection": schema.BoolAttribute{
+				Description: "Automatically create outbound NAT rules that assist reflection for 1:1 NAT and port forwards.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"static_route_filtering": schema.BoolAttribute{
+				Description: "Bypass firewall rules for traffic on the same interface, for static routes where the gateway is on the same interface as the route.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_reply_to": schema.BoolAttribute{
+				Description:         "Disable 'reply-to' on WAN rules.",
+				MarkdownDescription: "Disable `reply-to` on WAN rules.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FirewallAdvancedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallAdvancedResource) update(ctx context.Context, config *FirewallAdvancedResourceModel) (*FirewallAdvancedResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetFirewallAdvanced(ctx)
+	if addError(&diags, "Error reading firewall advanced settings", err) {
+		return nil, diags
+	}
+
+	advReq, d := config.Value(ctx, *current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	adv, err := r.client.UpdateFirewallAdvanced(ctx, *advReq)
+	if addError(&diags, "Error updating firewall advanced settings", err) {
+		return nil, diags
+	}
+
+	var data FirewallAdvancedResourceModel
+	diags.Append(data.SetFromValue(ctx, adv)...)
+
+	return &data, diags
+}
+
+func (r *FirewallAdvancedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config *FirewallAdvancedResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAdvancedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallAdvancedResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adv, err := r.client.GetFirewallAdvanced(ctx)
+	if addError(&resp.Diagnostics, "Error reading firewall advanced settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, adv)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAdvancedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config *FirewallAdvancedResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := r.update(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAdvancedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
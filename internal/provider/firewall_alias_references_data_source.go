@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallAliasReferencesDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallAliasReferencesDataSource{}
+)
+
+func NewFirewallAliasReferencesDataSource() datasource.DataSource {
+	return &FirewallAliasReferencesDataSource{}
+}
+
+type FirewallAliasReferencesDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallAliasReferencesDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	InUse      types.Bool   `tfsdk:"in_use"`
+	References types.List   `tfsdk:"references"`
+}
+
+type FirewallAliasReferenceDataSourceModel struct {
+	Type        types.String `tfsdk:"type"`
+	Index       types.Int64  `tfsdk:"index"`
+	Field       types.String `tfsdk:"field"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d FirewallAliasReferenceDataSourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":        types.StringType,
+		"index":       types.Int64Type,
+		"field":       types.StringType,
+		"description": types.StringType,
+	}}
+}
+
+func (d *FirewallAliasReferenceDataSourceModel) SetFromValue(ctx context.Context, ref *pfsense.FirewallAliasReference) diag.Diagnostics {
+	d.Type = types.StringValue(ref.Type)
+	d.Index = types.Int64Value(int64(ref.Index))
+	d.Field = types.StringValue(ref.Field)
+
+	if ref.Description != "" {
+		d.Description = types.StringValue(ref.Description)
+	}
+
+	return nil
+}
+
+func (d *FirewallAliasReferencesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_alias_references", req.ProviderTypeName)
+}
+
+func (d *FirewallAliasReferencesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the places a firewall alias is referenced. Filter rules, port forwards, outbound NAT rules, 1:1 NAT rules, other aliases, static routes, and the address and port settings of shaper queues and limiters are searched.",
+		MarkdownDescription: "Retrieves the places a firewall [alias](https://docs.netgate.com/pfsense/en/latest/firewall/aliases.html) is referenced. Filter rules, port forwards, outbound NAT rules, 1:1 NAT rules, other aliases, static routes, and the address and port settings of shaper queues and limiters are searched.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of alias.",
+				Required:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Alias is referenced at least once.",
+				Computed:    true,
+			},
+			"references": schema.ListNestedAttribute{
+				Description: "References to the alias.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: fmt.Sprintf("Type of referencing item, one of '%s', '%s', '%s', '%s', '%s', '%s', '%s', or '%s'.",
+								pfsense.FirewallAliasReferenceTypeFilterRule, pfsense.FirewallAliasReferenceTypeNATPortForward, pfsense.FirewallAliasReferenceTypeNATOutbound,
+								pfsense.FirewallAliasReferenceTypeNATOneToOne, pfsense.FirewallAliasReferenceTypeAlias, pfsense.FirewallAliasReferenceTypeStaticRoute,
+								pfsense.FirewallAliasReferenceTypeShaper, pfsense.FirewallAliasReferenceTypeLimiter),
+							MarkdownDescription: fmt.Sprintf("Type of referencing item, one of `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, or `%s`.",
+								pfsense.FirewallAliasReferenceTypeFilterRule, pfsense.FirewallAliasReferenceTypeNATPortForward, pfsense.FirewallAliasReferenceTypeNATOutbound,
+								pfsense.FirewallAliasReferenceTypeNATOneToOne, pfsense.FirewallAliasReferenceTypeAlias, pfsense.FirewallAliasReferenceTypeStaticRoute,
+								pfsense.FirewallAliasReferenceTypeShaper, pfsense.FirewallAliasReferenceTypeLimiter),
+							Computed: true,
+						},
+						"index": schema.Int64Attribute{
+							Description: "Position of the referencing item within its section of the configuration.",
+							Computed:    true,
+						},
+						"field": schema.StringAttribute{
+							Description: "Field of the referencing item which contains the alias.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the referencing item.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallAliasReferencesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallAliasReferencesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallAliasReferencesDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refs, err := d.client.GetFirewallAliasReferences(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get alias references", err) {
+		return
+	}
+
+	refModels := []FirewallAliasReferenceDataSourceModel{}
+	for _, ref := range *refs {
+		var refModel FirewallAliasReferenceDataSourceModel
+		ref := ref
+		diags = refModel.SetFromValue(ctx, &ref)
+		resp.Diagnostics.Append(diags...)
+		refModels = append(refModels, refModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.InUse = types.BoolValue(len(refModels) > 0)
+	data.References, diags = types.ListValueFrom(ctx, FirewallAliasReferenceDataSourceModel{}.GetAttrType(), refModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
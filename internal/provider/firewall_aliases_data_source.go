@@ -47,12 +47,14 @@ func (d FirewallIPAliasDataSourceModel) GetAttrType() attr.Type {
 
 type FirewallIPAliasEntryDataSourceModel struct {
 	Address     types.String `tfsdk:"address"`
+	Type        types.String `tfsdk:"type"`
 	Description types.String `tfsdk:"description"`
 }
 
 func (d FirewallIPAliasEntryDataSourceModel) GetAttrType() attr.Type {
 	return types.ObjectType{AttrTypes: map[string]attr.Type{
 		"address":     types.StringType,
+		"type":        types.StringType,
 		"description": types.StringType,
 	}}
 }
@@ -73,6 +75,7 @@ func (d *FirewallIPAliasDataSourceModel) SetFromValue(ctx context.Context, ipAli
 		var entryModel FirewallIPAliasEntryDataSourceModel
 
 		entryModel.Address = types.StringValue(entry.Address)
+		entryModel.Type = types.StringValue(string(entry.Type))
 
 		if entry.Description != "" {
 			entryModel.Description = types.StringValue(entry.Description)
@@ -109,8 +112,9 @@ func (d *FirewallAliasesDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:    true,
 						},
 						"type": schema.StringAttribute{
-							Description: "Type of alias.",
-							Computed:    true,
+							Description:         "Type of alias, either 'host' or 'network'.",
+							MarkdownDescription: "Type of alias, either `host` or `network`.",
+							Computed:            true,
 						},
 						"entries": schema.ListNestedAttribute{
 							Description: "Host(s) or network(s).",
@@ -121,6 +125,11 @@ func (d *FirewallAliasesDataSource) Schema(_ context.Context, _ datasource.Schem
 										Description: "Hosts must be specified by their IP address or fully qualified domain name (FQDN). Networks are specified in CIDR format.",
 										Computed:    true,
 									},
+									"type": schema.StringAttribute{
+										Description:         "Type of entry address, one of 'ip', 'cidr', 'range', 'fqdn', or 'alias'.",
+										MarkdownDescription: "Type of entry address, one of `ip`, `cidr`, `range`, `fqdn`, or `alias`.",
+										Computed:            true,
+									},
 									"description": schema.StringAttribute{
 										Description: "For administrative reference (not parsed).",
 										Computed:    true,
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallCARPMaintenanceModeResource{}
+
+func NewFirewallCARPMaintenanceModeResource() resource.Resource {
+	return &FirewallCARPMaintenanceModeResource{}
+}
+
+type FirewallCARPMaintenanceModeResource struct {
+	client *pfsense.Client
+}
+
+type FirewallCARPMaintenanceModeResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_carp_maintenance_mode", req.ProviderTypeName)
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall CARP persistent maintenance mode, demotes all CARP virtual IPs so that another cluster member becomes primary, and keeps them demoted across reboots. Only one instance of this resource should exist, destroying it leaves maintenance mode.",
+		MarkdownDescription: "Firewall [CARP](https://docs.netgate.com/pfsense/en/latest/highavailability/index.html) persistent maintenance mode, demotes all CARP virtual IPs so that another cluster member becomes primary, and keeps them demoted across reboots. Only one instance of this resource should exist, destroying it leaves maintenance mode.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description:         "Enter persistent CARP maintenance mode, defaults to 'true'.",
+				MarkdownDescription: "Enter persistent CARP maintenance mode, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallCARPMaintenanceModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetCARPMaintenanceMode(ctx, data.Enabled.ValueBool())
+	if addError(&resp.Diagnostics, "Error setting CARP maintenance mode", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallCARPMaintenanceModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, err := r.client.GetCARPMaintenanceMode(ctx)
+	if addError(&resp.Diagnostics, "Error reading CARP maintenance mode", err) {
+		return
+	}
+
+	data.Enabled = types.BoolValue(enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallCARPMaintenanceModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetCARPMaintenanceMode(ctx, data.Enabled.ValueBool())
+	if addError(&resp.Diagnostics, "Error setting CARP maintenance mode", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallCARPMaintenanceModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	err := r.client.SetCARPMaintenanceMode(ctx, false)
+	if addError(&resp.Diagnostics, "Error leaving CARP maintenance mode", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
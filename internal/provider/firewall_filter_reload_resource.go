@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +16,7 @@ import (
 )
 
 var _ resource.Resource = &FirewallFilterReloadResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallFilterReloadResource{}
 
 func NewFirewallFilterReloadResource() resource.Resource {
 	return &FirewallFilterReloadResource{}
@@ -25,8 +27,9 @@ type FirewallFilterReloadResource struct {
 }
 
 type FirewallFilterReloadResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID             types.String `tfsdk:"id"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+	PendingChanges types.Bool   `tfsdk:"pending_changes"`
 }
 
 func (r *FirewallFilterReloadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +38,7 @@ func (r *FirewallFilterReloadResource) Metadata(ctx context.Context, req resourc
 
 func (r *FirewallFilterReloadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Reload firewall filter.",
+		Description: "Reload firewall filter. Reloads again on the next run if pfSense reports pending rule, alias, or NAT changes.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "UUID for firewall filter reload.",
@@ -51,6 +54,13 @@ func (r *FirewallFilterReloadResource) Schema(ctx context.Context, req resource.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"pending_changes": schema.BoolAttribute{
+				Description: "pfSense reports firewall filter changes which have not been applied, planned as an update which applies them.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -79,14 +89,21 @@ func (r *FirewallFilterReloadResource) Create(ctx context.Context, req resource.
 
 	data.ID = types.StringValue(uuid.New().String())
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	data.PendingChanges = types.BoolValue(false)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FirewallFilterReloadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readPendingChanges(ctx, resp, "Error reading firewall filter pending changes", r.client.IsFirewallFilterDirty)
+}
+
+func (r *FirewallFilterReloadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPendingChangesPlan(ctx, req, resp)
 }
 
 func (r *FirewallFilterReloadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updatePendingChanges(ctx, req, resp, "Error reloading firewall filter", r.client.ReloadFirewallFilter)
 }
 
 func (r *FirewallFilterReloadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
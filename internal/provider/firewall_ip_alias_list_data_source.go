@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource                   = &FirewallIPAliasListDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &FirewallIPAliasListDataSource{}
+)
+
+func NewFirewallIPAliasListDataSource() datasource.DataSource {
+	return &FirewallIPAliasListDataSource{}
+}
+
+// FirewallIPAliasListDataSource parses and renders address lists locally, it does not call pfSense.
+type FirewallIPAliasListDataSource struct{}
+
+type FirewallIPAliasListDataSourceModel struct {
+	Format  types.String `tfsdk:"format"`
+	Content types.String `tfsdk:"content"`
+	Entries types.List   `tfsdk:"entries"`
+}
+
+func (d *FirewallIPAliasListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_ip_alias_list", req.ProviderTypeName)
+}
+
+func (d *FirewallIPAliasListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses an address list into firewall IP alias entries, or renders entries as an address list. Set exactly one of content or entries. " +
+			"Plaintext lists contain one address per line followed by an optional description (the format of the pfSense alias import page), CSV lists contain address and description columns. Blank lines and lines starting with '#' are ignored.",
+		MarkdownDescription: "Parses an address list into firewall IP alias entries, or renders entries as an address list. Set exactly one of `content` or `entries`. " +
+			"Plaintext lists contain one address per line followed by an optional description (the format of the pfSense alias [import](https://docs.netgate.com/pfsense/en/latest/firewall/aliases.html#bulk-import-network-aliases) page), CSV lists contain address and description columns. Blank lines and lines starting with `#` are ignored.",
+		Attributes: map[string]schema.Attribute{
+			"format": schema.StringAttribute{
+				Description:         fmt.Sprintf("Format of list, options: '%s', defaults to '%s'.", strings.Join(pfsense.FirewallIPAliasListFormats, "', '"), pfsense.FirewallIPAliasListFormatPlaintext),
+				MarkdownDescription: fmt.Sprintf("Format of list, options: `%s`, defaults to `%s`.", strings.Join(pfsense.FirewallIPAliasListFormats, "`, `"), pfsense.FirewallIPAliasListFormatPlaintext),
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				Description:         "Address list, for example the contents of a file read with 'file()'.",
+				MarkdownDescription: "Address list, for example the contents of a file read with `file()`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description:         "Entries of list, suitable for the 'entries' attribute of the 'pfsense_firewall_ip_alias' resource.",
+				MarkdownDescription: "Entries of list, suitable for the `entries` attribute of the `pfsense_firewall_ip_alias` resource.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "Hosts must be specified by their IP address or fully qualified domain name (FQDN). Networks are specified in CIDR format.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallIPAliasListDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *FirewallIPAliasListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Content.IsUnknown() || data.Entries.IsUnknown() {
+		return
+	}
+
+	if data.Content.IsNull() == data.Entries.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid address list configuration",
+			"Exactly one of 'content' or 'entries' must be set.",
+		)
+	}
+}
+
+func (d *FirewallIPAliasListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallIPAliasListDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format := pfsense.FirewallIPAliasListFormatPlaintext
+	if !data.Format.IsNull() {
+		format = data.Format.ValueString()
+	}
+
+	err := pfsense.ValidateFirewallIPAliasListFormat(format)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Format cannot be parsed",
+			err.Error(),
+		)
+
+		return
+	}
+
+	if !data.Content.IsNull() {
+		entries, err := pfsense.ParseFirewallIPAliasList(data.Content.ValueString(), format)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Content cannot be parsed",
+				err.Error(),
+			)
+
+			return
+		}
+
+		entryModels := []FirewallIPAliasEntryResourceModel{}
+		for _, entry := range entries {
+			var entryModel FirewallIPAliasEntryResourceModel
+
+			entryModel.Address = types.StringValue(entry.Address)
+
+			if entry.Description != "" {
+				entryModel.Description = types.StringValue(entry.Description)
+			}
+
+			entryModels = append(entryModels, entryModel)
+		}
+
+		data.Entries, diags = types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), entryModels)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		var entryModels []FirewallIPAliasEntryResourceModel
+		resp.Diagnostics.Append(data.Entries.ElementsAs(ctx, &entryModels, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var entries []pfsense.FirewallIPAliasEntry
+		for i, entryModel := range entryModels {
+			var entry pfsense.FirewallIPAliasEntry
+			var err error
+
+			err = entry.SetAddress(entryModel.Address.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("entries").AtListIndex(i).AtName("address"),
+					"Address cannot be parsed",
+					err.Error(),
+				)
+			}
+
+			err = entry.SetDescription(entryModel.Description.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("entries").AtListIndex(i).AtName("description"),
+					"Description cannot be parsed",
+					err.Error(),
+				)
+			}
+
+			entries = append(entries, entry)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		content, err := pfsense.FormatFirewallIPAliasList(entries, format)
+		if addError(&resp.Diagnostics, "Unable to render address list", err) {
+			return
+		}
+
+		data.Content = types.StringValue(content)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,11 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
 )
 
 var _ resource.Resource = &FirewallIPAliasResource{}
 var _ resource.ResourceWithImportState = &FirewallIPAliasResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallIPAliasResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallIPAliasResource{}
 
 func NewFirewallIPAliasResource() resource.Resource {
 	return &FirewallIPAliasResource{}
@@ -130,6 +135,12 @@ func (r FirewallIPAliasResourceModel) Value(ctx context.Context) (*pfsense.Firew
 				"Entry address cannot be parsed",
 				err.Error(),
 			)
+		} else if err = ipAlias.ValidateEntry(entry); err != nil {
+			diags.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("address"),
+				"Entry address is not valid for alias type",
+				err.Error(),
+			)
 		}
 
 		if !entryModel.Description.IsNull() {
@@ -150,6 +161,58 @@ func (r FirewallIPAliasResourceModel) Value(ctx context.Context) (*pfsense.Firew
 	return &ipAlias, diags
 }
 
+type knownFirewallIPAliasEntry struct {
+	index int
+	entry pfsense.FirewallIPAliasEntry
+}
+
+// knownEntries parses the entries whose address is known, unknown addresses are skipped during validation and planning.
+func (r FirewallIPAliasResourceModel) knownEntries(ctx context.Context) ([]knownFirewallIPAliasEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var entries []knownFirewallIPAliasEntry
+
+	if r.Entries.IsNull() || r.Entries.IsUnknown() {
+		return nil, diags
+	}
+
+	var entryObjects []types.Object
+	diags = r.Entries.ElementsAs(ctx, &entryObjects, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, entryObject := range entryObjects {
+		if entryObject.IsNull() || entryObject.IsUnknown() {
+			continue
+		}
+
+		var entryModel FirewallIPAliasEntryResourceModel
+		diags.Append(entryObject.As(ctx, &entryModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if entryModel.Address.IsUnknown() {
+			continue
+		}
+
+		var entry pfsense.FirewallIPAliasEntry
+		err := entry.SetAddress(entryModel.Address.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("address"),
+				"Entry address cannot be parsed",
+				err.Error(),
+			)
+			continue
+		}
+
+		entries = append(entries, knownFirewallIPAliasEntry{index: i, entry: entry})
+	}
+
+	return entries, diags
+}
+
 func (r *FirewallIPAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_firewall_ip_alias", req.ProviderTypeName)
 }
@@ -171,8 +234,9 @@ func (r *FirewallIPAliasResource) Schema(ctx context.Context, req resource.Schem
 				Optional:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of alias.",
-				Required:    true,
+				Description:         fmt.Sprintf("Type of alias, either '%s' or '%s'.", pfsense.FirewallIPAliasTypeHost, pfsense.FirewallIPAliasTypeNetwork),
+				MarkdownDescription: fmt.Sprintf("Type of alias, either `%s` or `%s`.", pfsense.FirewallIPAliasTypeHost, pfsense.FirewallIPAliasTypeNetwork),
+				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -192,8 +256,9 @@ func (r *FirewallIPAliasResource) Schema(ctx context.Context, req resource.Schem
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"address": schema.StringAttribute{
-							Description: "Hosts must be specified by their IP address or fully qualified domain name (FQDN). Networks are specified in CIDR format.",
-							Required:    true,
+							Description:         "Hosts must be specified by their IP address, IP range (e.g. '192.168.1.1-192.168.1.10'), fully qualified domain name (FQDN), or the name of another alias. Networks are specified in CIDR format.",
+							MarkdownDescription: "Hosts must be specified by their IP address, IP range (e.g. `192.168.1.1-192.168.1.10`), fully qualified domain name (FQDN), or the name of another alias. Networks are specified in CIDR format.",
+							Required:            true,
 						},
 						"description": schema.StringAttribute{
 							Description: "For administrative reference (not parsed).",
@@ -219,6 +284,114 @@ func (r *FirewallIPAliasResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *FirewallIPAliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallIPAliasResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ipAlias pfsense.FirewallIPAlias
+
+	if !data.Name.IsUnknown() {
+		_ = ipAlias.SetName(data.Name.ValueString())
+	}
+
+	if !data.Type.IsUnknown() {
+		err := ipAlias.SetType(data.Type.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Type cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	entries, diags := data.knownEntries(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if ipAlias.Type == "" {
+		return
+	}
+
+	for _, known := range entries {
+		err := ipAlias.ValidateEntry(known.entry)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entries").AtListIndex(known.index).AtName("address"),
+				"Entry address is not valid for alias type",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *FirewallIPAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *FirewallIPAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Name.IsUnknown() || data.Type.IsUnknown() {
+		return
+	}
+
+	var ipAlias pfsense.FirewallIPAlias
+	if ipAlias.SetName(data.Name.ValueString()) != nil || ipAlias.SetType(data.Type.ValueString()) != nil {
+		return
+	}
+
+	// parsing errors have already been reported during config validation
+	entries, _ := data.knownEntries(ctx)
+
+	var references []knownFirewallIPAliasEntry
+	for _, known := range entries {
+		ipAlias.Entries = append(ipAlias.Entries, known.entry)
+		if known.entry.Type == pfsense.FirewallIPAliasEntryTypeAlias {
+			references = append(references, known)
+		}
+	}
+
+	if len(references) == 0 {
+		return
+	}
+
+	ipAliases, err := r.client.GetFirewallIPAliases(ctx)
+	if addError(&resp.Diagnostics, "Unable to get IP aliases", err) {
+		return
+	}
+
+	if cycle := ipAliases.NestedCycle(ipAlias); cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entries"),
+			"Nested alias cycle",
+			fmt.Sprintf("Alias entries would create a cycle: %s.", strings.Join(cycle, " -> ")),
+		)
+	}
+
+	for _, known := range references {
+		err = ipAliases.ValidateEntryReference(ipAlias, known.entry)
+
+		if errors.Is(err, pfsense.ErrNotFound) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("entries").AtListIndex(known.index).AtName("address"),
+				"Referenced alias does not exist",
+				fmt.Sprintf("%s. The alias must be created before this alias is applied.", err.Error()),
+			)
+		} else if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entries").AtListIndex(known.index).AtName("address"),
+				"Entry address references an invalid alias",
+				err.Error(),
+			)
+		}
+	}
+}
+
 func (r *FirewallIPAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *FirewallIPAliasResourceModel
 	var diags diag.Diagnostics
@@ -327,6 +500,20 @@ func (r *FirewallIPAliasResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	err := r.client.DeleteFirewallIPAlias(ctx, data.Name.ValueString())
+
+	var inUseErr *pfsense.AliasInUseError
+	if errors.As(err, &inUseErr) {
+		var refs []string
+		for _, ref := range inUseErr.References {
+			refs = append(refs, fmt.Sprintf("- %s", ref))
+		}
+		resp.Diagnostics.AddError(
+			"IP alias in use",
+			fmt.Sprintf("IP alias '%s' cannot be deleted while it is referenced by:\n%s", inUseErr.Name, strings.Join(refs, "\n")),
+		)
+		return
+	}
+
 	if addError(&resp.Diagnostics, "Error deleting IP alias", err) {
 		return
 	}
@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallLimiterQueueResource{}
+var _ resource.ResourceWithImportState = &FirewallLimiterQueueResource{}
+
+func NewFirewallLimiterQueueResource() resource.Resource {
+	return &FirewallLimiterQueueResource{}
+}
+
+type FirewallLimiterQueueResource struct {
+	client *pfsense.Client
+}
+
+type FirewallLimiterQueueResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Limiter         types.String `tfsdk:"limiter"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Mask            types.String `tfsdk:"mask"`
+	MaskIPv4Bits    types.Int64  `tfsdk:"mask_ipv4_bits"`
+	MaskIPv6Bits    types.Int64  `tfsdk:"mask_ipv6_bits"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	QueueLength     types.Int64  `tfsdk:"queue_length"`
+	QueueManagement types.String `tfsdk:"queue_management"`
+	ECN             types.Bool   `tfsdk:"ecn"`
+	Apply           types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallLimiterQueueResourceModel) SetFromValue(ctx context.Context, queue *pfsense.FirewallLimiterQueue) diag.Diagnostics {
+	r.Name = types.StringValue(queue.Name)
+	r.Limiter = types.StringValue(queue.Limiter)
+	r.Enabled = types.BoolValue(queue.Enabled)
+	r.Mask = types.StringValue(queue.Mask.Type)
+	r.MaskIPv4Bits = optionalInt64Value(queue.Mask.IPv4Bits)
+	r.MaskIPv6Bits = optionalInt64Value(queue.Mask.IPv6Bits)
+	r.Weight = optionalInt64Value(queue.Weight)
+	r.QueueLength = optionalInt64Value(queue.QueueLength)
+	r.QueueManagement = types.StringValue(queue.QueueManagement)
+	r.ECN = types.BoolValue(queue.ECN)
+
+	if queue.Description != "" {
+		r.Description = types.StringValue(queue.Description)
+	}
+
+	return nil
+}
+
+func (r FirewallLimiterQueueResourceModel) Value(ctx context.Context) (*pfsense.FirewallLimiterQueue, diag.Diagnostics) {
+	var queue pfsense.FirewallLimiterQueue
+	var err error
+	var diags diag.Diagnostics
+
+	err = queue.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetLimiter(r.Limiter.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("limiter"),
+			"Limiter cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = queue.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = queue.SetEnabled(r.Enabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	mask, d := firewallLimiterMaskValue(r.Mask, r.MaskIPv4Bits, r.MaskIPv6Bits)
+	diags.Append(d...)
+
+	if mask != nil {
+		err = queue.SetMask(*mask)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("mask"),
+				"Mask cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Weight.IsNull() {
+		err = queue.SetWeight(int(r.Weight.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("weight"),
+				"Weight cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.QueueLength.IsNull() {
+		err = queue.SetQueueLength(int(r.QueueLength.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("queue_length"),
+				"Queue length cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = queue.SetQueueManagement(r.QueueManagement.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("queue_management"),
+			"Queue management cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetECN(r.ECN.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ecn"),
+			"ECN cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &queue, diags
+	}
+
+	err = queue.Validate()
+
+	if err != nil {
+		diags.AddError(
+			"Limiter queue is not valid",
+			err.Error(),
+		)
+	}
+
+	return &queue, diags
+}
+
+func (r *FirewallLimiterQueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_limiter_queue", req.ProviderTypeName)
+}
+
+func (r *FirewallLimiterQueueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "Name of queue, must be unique across all limiters and queues.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"limiter": schema.StringAttribute{
+			Description: "Name of the limiter the queue belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "For administrative reference (not parsed).",
+			Optional:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description:         "Enable queue, defaults to 'true'.",
+			MarkdownDescription: "Enable queue, defaults to `true`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"weight": schema.Int64Attribute{
+			Description: "Share of the limiter bandwidth given to the queue relative to its siblings (1-100).",
+			Optional:    true,
+		},
+		"queue_length": schema.Int64Attribute{
+			Description: "Number of packets that can be queued before they are dropped, pfSense uses the dummynet default when unset.",
+			Optional:    true,
+		},
+		"queue_management": schema.StringAttribute{
+			Description:         fmt.Sprintf("Queue management algorithm, one of '%s', defaults to '%s'.", strings.Join(pfsense.FirewallLimiterQueueManagements, "', '"), pfsense.FirewallLimiterQueueManagementDefault),
+			MarkdownDescription: fmt.Sprintf("Queue management algorithm, one of `%s`, defaults to `%s`.", strings.Join(pfsense.FirewallLimiterQueueManagements, "`, `"), pfsense.FirewallLimiterQueueManagementDefault),
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(pfsense.FirewallLimiterQueueManagementDefault),
+		},
+		"ecn": schema.BoolAttribute{
+			Description:         "Enable explicit congestion notification, requires an active queue management algorithm, defaults to 'false'.",
+			MarkdownDescription: "Enable explicit congestion notification, requires an active queue management algorithm, defaults to `false`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"apply": schema.BoolAttribute{
+			Description:         "Apply change, defaults to 'true'.",
+			MarkdownDescription: "Apply change, defaults to `true`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+
+	for name, attribute := range firewallLimiterMaskSchemaAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Firewall limiter queue, a child of a limiter which shares the limiter bandwidth with its sibling queues by weight.",
+		MarkdownDescription: "Firewall [limiter](https://docs.netgate.com/pfsense/en/latest/trafficshaper/limiters.html) queue, a child of a limiter which shares the limiter bandwidth with its sibling queues by weight.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *FirewallLimiterQueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallLimiterQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallLimiterQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.CreateFirewallLimiterQueue(ctx, *queueReq)
+	if addError(&resp.Diagnostics, "Error creating limiter queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallLimiterQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.GetFirewallLimiterQueue(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading limiter queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallLimiterQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallLimiterQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.UpdateFirewallLimiterQueue(ctx, *queueReq)
+	if addError(&resp.Diagnostics, "Error updating limiter queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallLimiterQueueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallLimiterQueue(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting limiter queue", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,727 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallLimiterResource{}
+var _ resource.ResourceWithImportState = &FirewallLimiterResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallLimiterResource{}
+
+func NewFirewallLimiterResource() resource.Resource {
+	return &FirewallLimiterResource{}
+}
+
+type FirewallLimiterResource struct {
+	client *pfsense.Client
+}
+
+type FirewallLimiterResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Bandwidths      types.List   `tfsdk:"bandwidths"`
+	Mask            types.String `tfsdk:"mask"`
+	MaskIPv4Bits    types.Int64  `tfsdk:"mask_ipv4_bits"`
+	MaskIPv6Bits    types.Int64  `tfsdk:"mask_ipv6_bits"`
+	QueueLength     types.Int64  `tfsdk:"queue_length"`
+	Delay           types.Int64  `tfsdk:"delay"`
+	Scheduler       types.String `tfsdk:"scheduler"`
+	FQCoDel         types.Object `tfsdk:"fq_codel"`
+	QueueManagement types.String `tfsdk:"queue_management"`
+	ECN             types.Bool   `tfsdk:"ecn"`
+	Apply           types.Bool   `tfsdk:"apply"`
+}
+
+type FirewallLimiterBandwidthModel struct {
+	Bandwidth types.Int64  `tfsdk:"bandwidth"`
+	Unit      types.String `tfsdk:"unit"`
+	Schedule  types.String `tfsdk:"schedule"`
+}
+
+type FirewallLimiterFQCoDelModel struct {
+	Target   types.Int64 `tfsdk:"target"`
+	Interval types.Int64 `tfsdk:"interval"`
+	Quantum  types.Int64 `tfsdk:"quantum"`
+	Limit    types.Int64 `tfsdk:"limit"`
+	Flows    types.Int64 `tfsdk:"flows"`
+}
+
+func (r FirewallLimiterBandwidthModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"bandwidth": types.Int64Type,
+		"unit":      types.StringType,
+		"schedule":  types.StringType,
+	}}
+}
+
+func (r FirewallLimiterFQCoDelModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"target":   types.Int64Type,
+		"interval": types.Int64Type,
+		"quantum":  types.Int64Type,
+		"limit":    types.Int64Type,
+		"flows":    types.Int64Type,
+	}
+}
+
+func (r *FirewallLimiterBandwidthModel) SetFromValue(ctx context.Context, bandwidth *pfsense.FirewallLimiterBandwidth) diag.Diagnostics {
+	r.Bandwidth = types.Int64Value(int64(bandwidth.Bandwidth))
+	r.Unit = types.StringValue(bandwidth.Unit)
+
+	if bandwidth.Schedule != "" {
+		r.Schedule = types.StringValue(bandwidth.Schedule)
+	}
+
+	return nil
+}
+
+func (r FirewallLimiterBandwidthModel) Value(ctx context.Context, p path.Path) (*pfsense.FirewallLimiterBandwidth, diag.Diagnostics) {
+	var bandwidth pfsense.FirewallLimiterBandwidth
+	var err error
+	var diags diag.Diagnostics
+
+	err = bandwidth.SetBandwidth(int(r.Bandwidth.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			p.AtName("bandwidth"),
+			"Bandwidth cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = bandwidth.SetUnit(r.Unit.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			p.AtName("unit"),
+			"Unit cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Schedule.IsNull() {
+		err = bandwidth.SetSchedule(r.Schedule.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				p.AtName("schedule"),
+				"Schedule cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &bandwidth, diags
+}
+
+// firewallLimiterMaskValue builds a mask from the mask attributes shared by limiters and limiter queues.
+func firewallLimiterMaskValue(maskType types.String, ipv4Bits types.Int64, ipv6Bits types.Int64) (*pfsense.FirewallLimiterMask, diag.Diagnostics) {
+	var mask pfsense.FirewallLimiterMask
+	var err error
+	var diags diag.Diagnostics
+
+	err = mask.SetType(maskType.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("mask"),
+			"Mask cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !ipv4Bits.IsNull() {
+		err = mask.SetIPv4Bits(int(ipv4Bits.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("mask_ipv4_bits"),
+				"Mask IPv4 bits cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !ipv6Bits.IsNull() {
+		err = mask.SetIPv6Bits(int(ipv6Bits.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("mask_ipv6_bits"),
+				"Mask IPv6 bits cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = mask.Validate()
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("mask"),
+			"Mask is not valid",
+			err.Error(),
+		)
+	}
+
+	return &mask, diags
+}
+
+func (r *FirewallLimiterResourceModel) SetFromValue(ctx context.Context, limiter *pfsense.FirewallLimiter) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	r.Name = types.StringValue(limiter.Name)
+	r.Enabled = types.BoolValue(limiter.Enabled)
+	r.Mask = types.StringValue(limiter.Mask.Type)
+	r.MaskIPv4Bits = optionalInt64Value(limiter.Mask.IPv4Bits)
+	r.MaskIPv6Bits = optionalInt64Value(limiter.Mask.IPv6Bits)
+	r.QueueLength = optionalInt64Value(limiter.QueueLength)
+	r.Delay = optionalInt64Value(limiter.Delay)
+	r.Scheduler = types.StringValue(limiter.Scheduler)
+	r.QueueManagement = types.StringValue(limiter.QueueManagement)
+	r.ECN = types.BoolValue(limiter.ECN)
+
+	if limiter.Description != "" {
+		r.Description = types.StringValue(limiter.Description)
+	}
+
+	bandwidths := []FirewallLimiterBandwidthModel{}
+	for _, bandwidth := range limiter.Bandwidths {
+		var bandwidthModel FirewallLimiterBandwidthModel
+		bandwidth := bandwidth
+		diags.Append(bandwidthModel.SetFromValue(ctx, &bandwidth)...)
+		bandwidths = append(bandwidths, bandwidthModel)
+	}
+
+	r.Bandwidths, d = types.ListValueFrom(ctx, FirewallLimiterBandwidthModel{}.GetAttrType(), bandwidths)
+	diags.Append(d...)
+
+	r.FQCoDel = types.ObjectNull(FirewallLimiterFQCoDelModel{}.AttrTypes())
+	if limiter.FQCoDel != nil {
+		r.FQCoDel, d = types.ObjectValueFrom(ctx, FirewallLimiterFQCoDelModel{}.AttrTypes(), FirewallLimiterFQCoDelModel{
+			Target:   types.Int64Value(int64(limiter.FQCoDel.Target)),
+			Interval: types.Int64Value(int64(limiter.FQCoDel.Interval)),
+			Quantum:  types.Int64Value(int64(limiter.FQCoDel.Quantum)),
+			Limit:    types.Int64Value(int64(limiter.FQCoDel.Limit)),
+			Flows:    types.Int64Value(int64(limiter.FQCoDel.Flows)),
+		})
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+func (r FirewallLimiterResourceModel) Value(ctx context.Context) (*pfsense.FirewallLimiter, diag.Diagnostics) {
+	var limiter pfsense.FirewallLimiter
+	var err error
+	var diags diag.Diagnostics
+
+	var bandwidthModels []*FirewallLimiterBandwidthModel
+	diags = r.Bandwidths.ElementsAs(ctx, &bandwidthModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = limiter.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = limiter.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = limiter.SetEnabled(r.Enabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	var bandwidths []pfsense.FirewallLimiterBandwidth
+	for i, bandwidthModel := range bandwidthModels {
+		bandwidth, d := bandwidthModel.Value(ctx, path.Root("bandwidths").AtListIndex(i))
+		diags.Append(d...)
+
+		if bandwidth != nil {
+			bandwidths = append(bandwidths, *bandwidth)
+		}
+	}
+
+	if len(bandwidths) == len(bandwidthModels) {
+		err = limiter.SetBandwidths(bandwidths)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("bandwidths"),
+				"Bandwidths cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	mask, d := firewallLimiterMaskValue(r.Mask, r.MaskIPv4Bits, r.MaskIPv6Bits)
+	diags.Append(d...)
+
+	if mask != nil {
+		err = limiter.SetMask(*mask)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("mask"),
+				"Mask cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.QueueLength.IsNull() {
+		err = limiter.SetQueueLength(int(r.QueueLength.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("queue_length"),
+				"Queue length cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Delay.IsNull() {
+		err = limiter.SetDelay(int(r.Delay.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("delay"),
+				"Delay cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = limiter.SetScheduler(r.Scheduler.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("scheduler"),
+			"Scheduler cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.FQCoDel.IsNull() {
+		var fqCoDelModel FirewallLimiterFQCoDelModel
+		diags.Append(r.FQCoDel.As(ctx, &fqCoDelModel, basetypes.ObjectAsOptions{})...)
+
+		err = limiter.SetFQCoDel(pfsense.FirewallLimiterFQCoDel{
+			Target:   int(fqCoDelModel.Target.ValueInt64()),
+			Interval: int(fqCoDelModel.Interval.ValueInt64()),
+			Quantum:  int(fqCoDelModel.Quantum.ValueInt64()),
+			Limit:    int(fqCoDelModel.Limit.ValueInt64()),
+			Flows:    int(fqCoDelModel.Flows.ValueInt64()),
+		})
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("fq_codel"),
+				"FQ_CoDel parameters cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = limiter.SetQueueManagement(r.QueueManagement.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("queue_management"),
+			"Queue management cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = limiter.SetECN(r.ECN.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ecn"),
+			"ECN cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &limiter, diags
+	}
+
+	err = limiter.Validate()
+
+	if err != nil {
+		diags.AddError(
+			"Limiter is not valid",
+			err.Error(),
+		)
+	}
+
+	return &limiter, diags
+}
+
+// firewallLimiterMaskSchemaAttributes are shared by limiters and limiter queues.
+func firewallLimiterMaskSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mask": schema.StringAttribute{
+			Description:         fmt.Sprintf("Create a dynamic pipe for each address matching the mask, one of '%s', defaults to 'none'.", strings.Join(pfsense.FirewallLimiterMasks, "', '")),
+			MarkdownDescription: fmt.Sprintf("Create a dynamic pipe for each address matching the mask, one of `%s`, defaults to `none`.", strings.Join(pfsense.FirewallLimiterMasks, "`, `")),
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(pfsense.FirewallLimiterMaskNone),
+		},
+		"mask_ipv4_bits": schema.Int64Attribute{
+			Description: "IPv4 mask bits (1-32), pfSense uses 32 when unset. Requires a source or destination mask.",
+			Optional:    true,
+		},
+		"mask_ipv6_bits": schema.Int64Attribute{
+			Description: "IPv6 mask bits (1-128), pfSense uses 128 when unset. Requires a source or destination mask.",
+			Optional:    true,
+		},
+	}
+}
+
+func (r *FirewallLimiterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_limiter", req.ProviderTypeName)
+}
+
+func (r *FirewallLimiterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "Name of limiter.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "For administrative reference (not parsed).",
+			Optional:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description:         "Enable limiter and its queues, defaults to 'true'.",
+			MarkdownDescription: "Enable limiter and its queues, defaults to `true`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"bandwidths": schema.ListNestedAttribute{
+			Description: fmt.Sprintf("Bandwidth limits, at most %d. A limit without a schedule applies whenever no scheduled limit is active.", pfsense.FirewallLimiterMaxBandwidths),
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"bandwidth": schema.Int64Attribute{
+						Description: "Bandwidth limit, in the chosen unit.",
+						Required:    true,
+					},
+					"unit": schema.StringAttribute{
+						Description:         fmt.Sprintf("Bandwidth unit, one of '%s', defaults to 'Mb'.", strings.Join(pfsense.FirewallLimiterBandwidthUnits, "', '")),
+						MarkdownDescription: fmt.Sprintf("Bandwidth unit, one of `%s`, defaults to `Mb`.", strings.Join(pfsense.FirewallLimiterBandwidthUnits, "`, `")),
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("Mb"),
+					},
+					"schedule": schema.StringAttribute{
+						Description: "Name of the firewall schedule during which the limit applies.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"queue_length": schema.Int64Attribute{
+			Description: "Number of packets that can be queued before they are dropped, pfSense uses the dummynet default when unset.",
+			Optional:    true,
+		},
+		"delay": schema.Int64Attribute{
+			Description: "Delay added to packets in milliseconds (0-10000), usually left unset.",
+			Optional:    true,
+		},
+		"scheduler": schema.StringAttribute{
+			Description:         fmt.Sprintf("Scheduler, one of '%s', defaults to '%s'.", strings.Join(pfsense.FirewallLimiterSchedulers, "', '"), pfsense.FirewallLimiterSchedulerDefault),
+			MarkdownDescription: fmt.Sprintf("Scheduler, one of `%s`, defaults to `%s`.", strings.Join(pfsense.FirewallLimiterSchedulers, "`, `"), pfsense.FirewallLimiterSchedulerDefault),
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(pfsense.FirewallLimiterSchedulerDefault),
+		},
+		"fq_codel": schema.SingleNestedAttribute{
+			Description:         "FQ_CoDel scheduler parameters, required when the scheduler is 'fq_codel' (use '{}' for the defaults).",
+			MarkdownDescription: "FQ_CoDel scheduler parameters, required when the scheduler is `fq_codel` (use `{}` for the defaults).",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"target": schema.Int64Attribute{
+					Description:         fmt.Sprintf("Acceptable minimum standing queue delay in milliseconds, defaults to '%d'.", pfsense.DefaultFirewallLimiterFQCoDel.Target),
+					MarkdownDescription: fmt.Sprintf("Acceptable minimum standing queue delay in milliseconds, defaults to `%d`.", pfsense.DefaultFirewallLimiterFQCoDel.Target),
+					Computed:            true,
+					Optional:            true,
+					Default:             int64default.StaticInt64(int64(pfsense.DefaultFirewallLimiterFQCoDel.Target)),
+				},
+				"interval": schema.Int64Attribute{
+					Description:         fmt.Sprintf("Interval over which the standing queue delay is measured in milliseconds, defaults to '%d'.", pfsense.DefaultFirewallLimiterFQCoDel.Interval),
+					MarkdownDescription: fmt.Sprintf("Interval over which the standing queue delay is measured in milliseconds, defaults to `%d`.", pfsense.DefaultFirewallLimiterFQCoDel.Interval),
+					Computed:            true,
+					Optional:            true,
+					Default:             int64default.StaticInt64(int64(pfsense.DefaultFirewallLimiterFQCoDel.Interval)),
+				},
+				"quantum": schema.Int64Attribute{
+					Description:         fmt.Sprintf("Bytes served from each flow per round, defaults to '%d'.", pfsense.DefaultFirewallLimiterFQCoDel.Quantum),
+					MarkdownDescription: fmt.Sprintf("Bytes served from each flow per round, defaults to `%d`.", pfsense.DefaultFirewallLimiterFQCoDel.Quantum),
+					Computed:            true,
+					Optional:            true,
+					Default:             int64default.StaticInt64(int64(pfsense.DefaultFirewallLimiterFQCoDel.Quantum)),
+				},
+				"limit": schema.Int64Attribute{
+					Description:         fmt.Sprintf("Hard limit on the number of queued packets, defaults to '%d'.", pfsense.DefaultFirewallLimiterFQCoDel.Limit),
+					MarkdownDescription: fmt.Sprintf("Hard limit on the number of queued packets, defaults to `%d`.", pfsense.DefaultFirewallLimiterFQCoDel.Limit),
+					Computed:            true,
+					Optional:            true,
+					Default:             int64default.StaticInt64(int64(pfsense.DefaultFirewallLimiterFQCoDel.Limit)),
+				},
+				"flows": schema.Int64Attribute{
+					Description:         fmt.Sprintf("Number of flow queues, defaults to '%d'.", pfsense.DefaultFirewallLimiterFQCoDel.Flows),
+					MarkdownDescription: fmt.Sprintf("Number of flow queues, defaults to `%d`.", pfsense.DefaultFirewallLimiterFQCoDel.Flows),
+					Computed:            true,
+					Optional:            true,
+					Default:             int64default.StaticInt64(int64(pfsense.DefaultFirewallLimiterFQCoDel.Flows)),
+				},
+			},
+		},
+		"queue_management": schema.StringAttribute{
+			Description:         fmt.Sprintf("Queue management algorithm, one of '%s', defaults to '%s'.", strings.Join(pfsense.FirewallLimiterQueueManagements, "', '"), pfsense.FirewallLimiterQueueManagementDefault),
+			MarkdownDescription: fmt.Sprintf("Queue management algorithm, one of `%s`, defaults to `%s`.", strings.Join(pfsense.FirewallLimiterQueueManagements, "`, `"), pfsense.FirewallLimiterQueueManagementDefault),
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(pfsense.FirewallLimiterQueueManagementDefault),
+		},
+		"ecn": schema.BoolAttribute{
+			Description:         "Enable explicit congestion notification, requires an active queue management algorithm or the 'fq_codel' or 'fq_pie' scheduler, defaults to 'false'.",
+			MarkdownDescription: "Enable explicit congestion notification, requires an active queue management algorithm or the `fq_codel` or `fq_pie` scheduler, defaults to `false`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"apply": schema.BoolAttribute{
+			Description:         "Apply change, defaults to 'true'.",
+			MarkdownDescription: "Apply change, defaults to `true`.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+
+	for name, attribute := range firewallLimiterMaskSchemaAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Firewall limiter, a dummynet pipe which enforces bandwidth limits on traffic assigned to it by filter rules.",
+		MarkdownDescription: "Firewall [limiter](https://docs.netgate.com/pfsense/en/latest/trafficshaper/limiters.html), a dummynet pipe which enforces bandwidth limits on traffic assigned to it by filter rules.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *FirewallLimiterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallLimiterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallLimiterResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Scheduler.IsUnknown() || data.FQCoDel.IsUnknown() {
+		return
+	}
+
+	fqCoDel := data.Scheduler.ValueString() == pfsense.FirewallLimiterSchedulerFQCoDel
+
+	if fqCoDel && data.FQCoDel.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fq_codel"),
+			"Missing FQ_CoDel parameters",
+			fmt.Sprintf("The '%s' scheduler requires FQ_CoDel parameters, use '{}' for the defaults.", pfsense.FirewallLimiterSchedulerFQCoDel),
+		)
+	}
+
+	if !fqCoDel && !data.FQCoDel.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fq_codel"),
+			"Unexpected FQ_CoDel parameters",
+			fmt.Sprintf("FQ_CoDel parameters require the '%s' scheduler.", pfsense.FirewallLimiterSchedulerFQCoDel),
+		)
+	}
+}
+
+func (r *FirewallLimiterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallLimiterResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiterReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiter, err := r.client.CreateFirewallLimiter(ctx, *limiterReq)
+	if addError(&resp.Diagnostics, "Error creating limiter", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, limiter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallLimiterResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiter, err := r.client.GetFirewallLimiter(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading limiter", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, limiter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallLimiterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallLimiterResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiterReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limiter, err := r.client.UpdateFirewallLimiter(ctx, *limiterReq)
+	if addError(&resp.Diagnostics, "Error updating limiter", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, limiter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallLimiterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallLimiter(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting limiter", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallLimiterChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying limiter", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallLimiterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
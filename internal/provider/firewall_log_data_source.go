@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallLogDataSource{}
+)
+
+func NewFirewallLogDataSource() datasource.DataSource {
+	return &FirewallLogDataSource{}
+}
+
+type FirewallLogDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallLogDataSourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Action    types.String `tfsdk:"action"`
+	Tracker   types.String `tfsdk:"tracker"`
+	Since     types.String `tfsdk:"since"`
+	Until     types.String `tfsdk:"until"`
+	Lines     types.Int64  `tfsdk:"lines"`
+	Skipped   types.Int64  `tfsdk:"skipped"`
+	All       types.List   `tfsdk:"all"`
+}
+
+type FirewallLogEntryModel struct {
+	Time               types.String `tfsdk:"time"`
+	RuleNumber         types.String `tfsdk:"rule_number"`
+	Tracker            types.String `tfsdk:"tracker"`
+	Interface          types.String `tfsdk:"interface"`
+	Reason             types.String `tfsdk:"reason"`
+	Action             types.String `tfsdk:"action"`
+	Direction          types.String `tfsdk:"direction"`
+	IPVersion          types.Int64  `tfsdk:"ip_version"`
+	Protocol           types.String `tfsdk:"protocol"`
+	Length             types.Int64  `tfsdk:"length"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.Int64  `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.Int64  `tfsdk:"destination_port"`
+	TCPFlags           types.String `tfsdk:"tcp_flags"`
+	ICMPType           types.String `tfsdk:"icmp_type"`
+}
+
+func (m FirewallLogEntryModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"time":                types.StringType,
+		"rule_number":         types.StringType,
+		"tracker":             types.StringType,
+		"interface":           types.StringType,
+		"reason":              types.StringType,
+		"action":              types.StringType,
+		"direction":           types.StringType,
+		"ip_version":          types.Int64Type,
+		"protocol":            types.StringType,
+		"length":              types.Int64Type,
+		"source_address":      types.StringType,
+		"source_port":         types.Int64Type,
+		"destination_address": types.StringType,
+		"destination_port":    types.Int64Type,
+		"tcp_flags":           types.StringType,
+		"icmp_type":           types.StringType,
+	}}
+}
+
+func (m *FirewallLogEntryModel) SetFromValue(ctx context.Context, entry *pfsense.FirewallLogEntry) diag.Diagnostics {
+	m.Time = types.StringValue(entry.Time.Format(time.RFC3339Nano))
+	m.RuleNumber = types.StringValue(entry.RuleNumber)
+	m.Tracker = types.StringValue(entry.Tracker)
+	m.Interface = types.StringValue(entry.Interface)
+	m.Reason = types.StringValue(entry.Reason)
+	m.Action = types.StringValue(entry.Action)
+	m.Direction = types.StringValue(entry.Direction)
+	m.IPVersion = types.Int64Value(int64(entry.IPVersion))
+	m.Protocol = types.StringValue(entry.Protocol)
+	m.Length = optionalInt64Value(entry.Length)
+	m.SourceAddress = types.StringValue(entry.SourceAddress.String())
+	m.SourcePort = optionalInt64Value(entry.SourcePort)
+	m.DestinationAddress = types.StringValue(entry.DestinationAddress.String())
+	m.DestinationPort = optionalInt64Value(entry.DestinationPort)
+
+	m.TCPFlags = types.StringNull()
+	if entry.TCPFlags != "" {
+		m.TCPFlags = types.StringValue(entry.TCPFlags)
+	}
+
+	m.ICMPType = types.StringNull()
+	if entry.ICMPType != "" {
+		m.ICMPType = types.StringValue(entry.ICMPType)
+	}
+
+	return nil
+}
+
+func (d FirewallLogDataSourceModel) Value(ctx context.Context) (*pfsense.FirewallLogFilter, diag.Diagnostics) {
+	var filter pfsense.FirewallLogFilter
+	var err error
+	var diags diag.Diagnostics
+
+	if !d.Interface.IsNull() {
+		err = filter.SetInterface(d.Interface.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("interface"),
+				"Interface cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !d.Action.IsNull() {
+		err = filter.SetAction(d.Action.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("action"),
+				"Action cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !d.Tracker.IsNull() {
+		err = filter.SetTracker(d.Tracker.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tracker"),
+				"Tracker cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !d.Since.IsNull() {
+		err = filter.SetSince(d.Since.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("since"),
+				"Since cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !d.Until.IsNull() {
+		err = filter.SetUntil(d.Until.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("until"),
+				"Until cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !d.Lines.IsNull() {
+		err = filter.SetLines(int(d.Lines.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("lines"),
+				"Lines cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &filter, diags
+}
+
+func (d *FirewallLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_log", req.ProviderTypeName)
+}
+
+func (d *FirewallLogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves recent firewall (filter) log entries, optionally filtered by interface, action, rule tracker ID, and time window.",
+		MarkdownDescription: "Retrieves recent firewall ([filter](https://docs.netgate.com/pfsense/en/latest/monitoring/logs/firewall.html)) log entries, optionally filtered by interface, action, rule tracker ID, and time window.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Only return entries on this interface, for example 'wan'.",
+				MarkdownDescription: "Only return entries on this interface, for example `wan`.",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				Description:         fmt.Sprintf("Only return entries with this action, options: '%s'.", strings.Join(pfsense.FirewallLogActions, "', '")),
+				MarkdownDescription: fmt.Sprintf("Only return entries with this action, options: `%s`.", strings.Join(pfsense.FirewallLogActions, "`, `")),
+				Optional:            true,
+			},
+			"tracker": schema.StringAttribute{
+				Description: "Only return entries logged by the rule with this tracker ID.",
+				Optional:    true,
+			},
+			"since": schema.StringAttribute{
+				Description:         "Only return entries logged at or after this RFC 3339 timestamp, for example '2024-01-01T00:00:00Z'.",
+				MarkdownDescription: "Only return entries logged at or after this RFC 3339 timestamp, for example `2024-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				Description: "Only return entries logged at or before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"lines": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Number of most recent log lines to search, defaults to '%d'.", pfsense.DefaultFirewallLogLines),
+				MarkdownDescription: fmt.Sprintf("Number of most recent log lines to search, defaults to `%d`.", pfsense.DefaultFirewallLogLines),
+				Optional:            true,
+			},
+			"skipped": schema.Int64Attribute{
+				Description: "Number of filter log lines which could not be parsed and were skipped, a warning is also reported when any are skipped.",
+				Computed:    true,
+			},
+			"all": schema.ListNestedAttribute{
+				Description: "All matching log entries, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.StringAttribute{
+							Description: "Time of entry (RFC 3339).",
+							Computed:    true,
+						},
+						"rule_number": schema.StringAttribute{
+							Description: "Rule number in the loaded ruleset.",
+							Computed:    true,
+						},
+						"tracker": schema.StringAttribute{
+							Description: "Tracker ID of the rule.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "Interface of entry.",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Reason for entry, usually 'match'.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action taken.",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description:         "Direction of packet, 'in' or 'out'.",
+							MarkdownDescription: "Direction of packet, `in` or `out`.",
+							Computed:            true,
+						},
+						"ip_version": schema.Int64Attribute{
+							Description: "IP version of packet, 4 or 6.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Protocol of packet.",
+							Computed:    true,
+						},
+						"length": schema.Int64Attribute{
+							Description: "Length of packet.",
+							Computed:    true,
+						},
+						"source_address": schema.StringAttribute{
+							Description: "Source address.",
+							Computed:    true,
+						},
+						"source_port": schema.Int64Attribute{
+							Description: "Source port (TCP and UDP only).",
+							Computed:    true,
+						},
+						"destination_address": schema.StringAttribute{
+							Description: "Destination address.",
+							Computed:    true,
+						},
+						"destination_port": schema.Int64Attribute{
+							Description: "Destination port (TCP and UDP only).",
+							Computed:    true,
+						},
+						"tcp_flags": schema.StringAttribute{
+							Description: "TCP flags (TCP only).",
+							Computed:    true,
+						},
+						"icmp_type": schema.StringAttribute{
+							Description: "ICMP type (ICMP only).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallLogDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := data.Value(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log, err := d.client.GetFirewallLog(ctx, *filter)
+	if addError(&resp.Diagnostics, "Unable to get firewall log", err) {
+		return
+	}
+
+	data.Skipped = types.Int64Value(int64(len(log.Skipped)))
+	if len(log.Skipped) > 0 {
+		resp.Diagnostics.AddWarning("Firewall log lines skipped", fmt.Sprintf("%d filter log line(s) could not be parsed and were skipped, first error: %v", len(log.Skipped), log.Skipped[0]))
+	}
+
+	entryModels := []FirewallLogEntryModel{}
+	for _, entry := range log.Entries {
+		var entryModel FirewallLogEntryModel
+		entry := entry
+		diags = entryModel.SetFromValue(ctx, &entry)
+		resp.Diagnostics.Append(diags...)
+		entryModels = append(entryModels, entryModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, FirewallLogEntryModel{}.GetAttrType(), entryModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATNPTResource{}
+var _ resource.ResourceWithImportState = &FirewallNATNPTResource{}
+
+func NewFirewallNATNPTResource() resource.Resource {
+	return &FirewallNATNPTResource{}
+}
+
+type FirewallNATNPTResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATNPTResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Interface      types.String `tfsdk:"interface"`
+	InternalPrefix types.String `tfsdk:"internal_prefix"`
+	ExternalPrefix types.String `tfsdk:"external_prefix"`
+	Description    types.String `tfsdk:"description"`
+	InternalInvert types.Bool   `tfsdk:"internal_invert"`
+	ExternalInvert types.Bool   `tfsdk:"external_invert"`
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Apply          types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATNPTResourceModel) SetFromValue(ctx context.Context, npt *pfsense.FirewallNATNPT) diag.Diagnostics {
+	r.ID = types.StringValue(npt.ID)
+	r.Interface = types.StringValue(npt.Interface)
+	r.InternalPrefix = types.StringValue(npt.InternalPrefix.String())
+	r.InternalInvert = types.BoolValue(npt.InternalInvert)
+	r.ExternalPrefix = types.StringValue(npt.ExternalPrefix.String())
+	r.ExternalInvert = types.BoolValue(npt.ExternalInvert)
+	r.Disabled = types.BoolValue(npt.Disabled)
+
+	if npt.Description != "" {
+		r.Description = types.StringValue(npt.Description)
+	}
+
+	return nil
+}
+
+func (r FirewallNATNPTResourceModel) Value(ctx context.Context) (*pfsense.FirewallNATNPT, diag.Diagnostics) {
+	var npt pfsense.FirewallNATNPT
+	var err error
+	var diags diag.Diagnostics
+
+	npt.ID = r.ID.ValueString()
+
+	err = npt.SetInterface(r.Interface.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = npt.SetInternalPrefix(r.InternalPrefix.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("internal_prefix"),
+			"Internal prefix cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = npt.SetInternalInvert(r.InternalInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("internal_invert"),
+			"Internal invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = npt.SetExternalPrefix(r.ExternalPrefix.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("external_prefix"),
+			"External prefix cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = npt.SetExternalInvert(r.ExternalInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("external_invert"),
+			"External invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = npt.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = npt.SetDisabled(r.Disabled.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &npt, diags
+	}
+
+	err = npt.Validate()
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("external_prefix"),
+			"External prefix does not match internal prefix",
+			err.Error(),
+		)
+	}
+
+	return &npt, diags
+}
+
+func (r *FirewallNATNPTResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_npt", req.ProviderTypeName)
+}
+
+func (r *FirewallNATNPTResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall NPt mapping, translates an internal IPv6 prefix to an external IPv6 prefix of the same length (RFC 6296).",
+		MarkdownDescription: "Firewall [NPt](https://docs.netgate.com/pfsense/en/latest/nat/npt.html) mapping, translates an internal IPv6 prefix to an external IPv6 prefix of the same length ([RFC 6296](https://www.rfc-editor.org/rfc/rfc6296)).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of mapping, kept in pfSense as a '[tf:<id>]' tag at the end of the description. Mappings created outside of the provider are identified by their creation time until updated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface to which the mapping applies, for example 'wan'.",
+				MarkdownDescription: "Interface to which the mapping applies, for example `wan`.",
+				Required:            true,
+			},
+			"internal_prefix": schema.StringAttribute{
+				Description:         "Internal IPv6 prefix, for example 'fd00:1234::/48'.",
+				MarkdownDescription: "Internal IPv6 prefix, for example `fd00:1234::/48`.",
+				Required:            true,
+			},
+			"internal_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the internal prefix match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the internal prefix match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"external_prefix": schema.StringAttribute{
+				Description:         "External (global) IPv6 prefix, must be the same length as the internal prefix, for example '2001:db8:1::/48'.",
+				MarkdownDescription: "External (global) IPv6 prefix, must be the same length as the internal prefix, for example `2001:db8:1::/48`.",
+				Required:            true,
+			},
+			"external_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the external prefix match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the external prefix match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable mapping, defaults to 'false'.",
+				MarkdownDescription: "Disable mapping, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATNPTResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATNPTResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATNPTResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nptReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	npt, err := r.client.CreateFirewallNATNPT(ctx, *nptReq)
+	if addError(&resp.Diagnostics, "Error creating NPt mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, npt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying NPt mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATNPTResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATNPTResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	npt, err := r.client.GetFirewallNATNPT(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading NPt mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, npt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATNPTResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATNPTResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nptReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	npt, err := r.client.UpdateFirewallNATNPT(ctx, *nptReq)
+	if addError(&resp.Diagnostics, "Error updating NPt mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, npt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying NPt mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATNPTResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallNATNPTResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallNATNPT(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting NPt mapping", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying NPt mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATNPTResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
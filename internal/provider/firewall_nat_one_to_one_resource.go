@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATOneToOneResource{}
+var _ resource.ResourceWithImportState = &FirewallNATOneToOneResource{}
+
+func NewFirewallNATOneToOneResource() resource.Resource {
+	return &FirewallNATOneToOneResource{}
+}
+
+type FirewallNATOneToOneResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATOneToOneResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Interface          types.String `tfsdk:"interface"`
+	ExternalAddress    types.String `tfsdk:"external_address"`
+	InternalAddress    types.String `tfsdk:"internal_address"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	Description        types.String `tfsdk:"description"`
+	NATReflection      types.String `tfsdk:"nat_reflection"`
+	InternalInvert     types.Bool   `tfsdk:"internal_invert"`
+	DestinationInvert  types.Bool   `tfsdk:"destination_invert"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	Apply              types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATOneToOneResourceModel) SetFromValue(ctx context.Context, oneToOne *pfsense.FirewallNATOneToOne) diag.Diagnostics {
+	r.ID = types.StringValue(oneToOne.ID)
+	r.Interface = types.StringValue(oneToOne.Interface)
+	r.ExternalAddress = types.StringValue(oneToOne.ExternalAddress.String())
+	r.InternalAddress = types.StringValue(oneToOne.InternalAddress)
+	r.InternalInvert = types.BoolValue(oneToOne.InternalInvert)
+	r.DestinationAddress = types.StringValue(oneToOne.DestinationAddress)
+	r.DestinationInvert = types.BoolValue(oneToOne.DestinationInvert)
+	r.NATReflection = types.StringValue(oneToOne.NATReflection)
+	r.Disabled = types.BoolValue(oneToOne.Disabled)
+
+	if oneToOne.Description != "" {
+		r.Description = types.StringValue(oneToOne.Description)
+	}
+
+	return nil
+}
+
+func (r FirewallNATOneToOneResourceModel) Value(ctx context.Context) (*pfsense.FirewallNATOneToOne, diag.Diagnostics) {
+	var oneToOne pfsense.FirewallNATOneToOne
+	var err error
+	var diags diag.Diagnostics
+
+	oneToOne.ID = r.ID.ValueString()
+
+	err = oneToOne.SetInterface(r.Interface.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetExternalAddress(r.ExternalAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("external_address"),
+			"External address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetInternalAddress(r.InternalAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("internal_address"),
+			"Internal address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetInternalInvert(r.InternalInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("internal_invert"),
+			"Internal invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetDestinationAddress(r.DestinationAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_address"),
+			"Destination address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetDestinationInvert(r.DestinationInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_invert"),
+			"Destination invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = oneToOne.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = oneToOne.SetDisabled(r.Disabled.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = oneToOne.SetNATReflection(r.NATReflection.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("nat_reflection"),
+			"NAT reflection cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &oneToOne, diags
+	}
+
+	err = oneToOne.Validate()
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("external_address"),
+			"External address does not match internal address",
+			err.Error(),
+		)
+	}
+
+	return &oneToOne, diags
+}
+
+func (r *FirewallNATOneToOneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_one_to_one", req.ProviderTypeName)
+}
+
+func (r *FirewallNATOneToOneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall 1:1 NAT mapping, maps an external address (or subnet) to an internal address (or subnet) in both directions.",
+		MarkdownDescription: "Firewall [1:1 NAT](https://docs.netgate.com/pfsense/en/latest/nat/1-1.html) mapping, maps an external address (or subnet) to an internal address (or subnet) in both directions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of mapping, kept in pfSense as a '[tf:<id>]' tag at the end of the description. Mappings created outside of the provider are identified by their creation time until updated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface to which the mapping applies, for example 'wan'.",
+				MarkdownDescription: "Interface to which the mapping applies, for example `wan`.",
+				Required:            true,
+			},
+			"external_address": schema.StringAttribute{
+				Description: "External IP address, the start of the external subnet when the internal address is a CIDR. The address family determines whether the mapping is IPv4 or IPv6.",
+				Required:    true,
+			},
+			"internal_address": schema.StringAttribute{
+				Description:         "Internal IP address, CIDR, or interface keyword (e.g. 'lan' for the LAN network).",
+				MarkdownDescription: "Internal IP address, CIDR, or interface keyword (e.g. `lan` for the LAN network).",
+				Required:            true,
+			},
+			"internal_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the internal address match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the internal address match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"destination_address": schema.StringAttribute{
+				Description:         "Destination to match, 'any', an IP address, CIDR, alias, or interface keyword, defaults to 'any'.",
+				MarkdownDescription: "Destination to match, `any`, an IP address, CIDR, alias, or interface keyword, defaults to `any`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the destination match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the destination match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable mapping, defaults to 'false'.",
+				MarkdownDescription: "Disable mapping, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nat_reflection": schema.StringAttribute{
+				Description: fmt.Sprintf("NAT reflection mode, one of '%s' (use system setting), '%s', or '%s', defaults to '%s'.",
+					pfsense.NATReflectionDefault, pfsense.NATReflectionEnable, pfsense.NATReflectionDisable, pfsense.NATReflectionDefault),
+				MarkdownDescription: fmt.Sprintf("NAT reflection mode, one of `%s` (use system setting), `%s`, or `%s`, defaults to `%s`.",
+					pfsense.NATReflectionDefault, pfsense.NATReflectionEnable, pfsense.NATReflectionDisable, pfsense.NATReflectionDefault),
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(pfsense.NATReflectionDefault),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATOneToOneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATOneToOneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATOneToOneResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oneToOneReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oneToOne, err := r.client.CreateFirewallNATOneToOne(ctx, *oneToOneReq)
+	if addError(&resp.Diagnostics, "Error creating 1:1 NAT mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, oneToOne)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying 1:1 NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOneToOneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATOneToOneResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oneToOne, err := r.client.GetFirewallNATOneToOne(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading 1:1 NAT mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, oneToOne)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATOneToOneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATOneToOneResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oneToOneReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oneToOne, err := r.client.UpdateFirewallNATOneToOne(ctx, *oneToOneReq)
+	if addError(&resp.Diagnostics, "Error updating 1:1 NAT mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, oneToOne)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying 1:1 NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOneToOneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallNATOneToOneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallNATOneToOne(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting 1:1 NAT mapping", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying 1:1 NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOneToOneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,508 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATOutboundMappingResource{}
+var _ resource.ResourceWithImportState = &FirewallNATOutboundMappingResource{}
+
+func NewFirewallNATOutboundMappingResource() resource.Resource {
+	return &FirewallNATOutboundMappingResource{}
+}
+
+type FirewallNATOutboundMappingResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATOutboundMappingResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Interface          types.String `tfsdk:"interface"`
+	Protocol           types.String `tfsdk:"protocol"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.String `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	TranslationAddress types.String `tfsdk:"translation_address"`
+	TranslationPort    types.String `tfsdk:"translation_port"`
+	PoolOptions        types.String `tfsdk:"pool_options"`
+	Description        types.String `tfsdk:"description"`
+	After              types.String `tfsdk:"after"`
+	DestinationInvert  types.Bool   `tfsdk:"destination_invert"`
+	StaticPort         types.Bool   `tfsdk:"static_port"`
+	NoNAT              types.Bool   `tfsdk:"no_nat"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	Apply              types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATOutboundMappingResourceModel) SetFromValue(ctx context.Context, mapping *pfsense.FirewallNATOutboundMapping) diag.Diagnostics {
+	r.ID = types.StringValue(mapping.ID)
+	r.Interface = types.StringValue(mapping.Interface)
+	r.Protocol = types.StringValue(mapping.Protocol)
+	r.SourceAddress = types.StringValue(mapping.SourceAddress)
+	r.DestinationAddress = types.StringValue(mapping.DestinationAddress)
+	r.DestinationInvert = types.BoolValue(mapping.DestinationInvert)
+	r.StaticPort = types.BoolValue(mapping.StaticPort)
+	r.NoNAT = types.BoolValue(mapping.NoNAT)
+	r.Disabled = types.BoolValue(mapping.Disabled)
+
+	if mapping.SourcePort != "" {
+		r.SourcePort = types.StringValue(mapping.SourcePort)
+	}
+
+	if mapping.DestinationPort != "" {
+		r.DestinationPort = types.StringValue(mapping.DestinationPort)
+	}
+
+	if mapping.TranslationAddress != "" {
+		r.TranslationAddress = types.StringValue(mapping.TranslationAddress)
+	}
+
+	if mapping.TranslationPort != "" {
+		r.TranslationPort = types.StringValue(mapping.TranslationPort)
+	}
+
+	if mapping.PoolOptions != "" {
+		r.PoolOptions = types.StringValue(mapping.PoolOptions)
+	}
+
+	if mapping.Description != "" {
+		r.Description = types.StringValue(mapping.Description)
+	}
+
+	// position is only tracked when managed
+	if !r.After.IsNull() {
+		r.After = types.StringValue(mapping.After)
+	}
+
+	return nil
+}
+
+func (r FirewallNATOutboundMappingResourceModel) Value(ctx context.Context) (*pfsense.FirewallNATOutboundMapping, diag.Diagnostics) {
+	var mapping pfsense.FirewallNATOutboundMapping
+	var err error
+	var diags diag.Diagnostics
+
+	mapping.ID = r.ID.ValueString()
+
+	err = mapping.SetInterface(r.Interface.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = mapping.SetProtocol(r.Protocol.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = mapping.SetSourceAddress(r.SourceAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_address"),
+			"Source address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.SourcePort.IsNull() {
+		err = mapping.SetSourcePort(r.SourcePort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source_port"),
+				"Source port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = mapping.SetDestinationAddress(r.DestinationAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_address"),
+			"Destination address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.DestinationPort.IsNull() {
+		err = mapping.SetDestinationPort(r.DestinationPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("destination_port"),
+				"Destination port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = mapping.SetDestinationInvert(r.DestinationInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_invert"),
+			"Destination invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.TranslationAddress.IsNull() {
+		err = mapping.SetTranslationAddress(r.TranslationAddress.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("translation_address"),
+				"Translation address cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.TranslationPort.IsNull() {
+		err = mapping.SetTranslationPort(r.TranslationPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("translation_port"),
+				"Translation port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.PoolOptions.IsNull() {
+		err = mapping.SetPoolOptions(r.PoolOptions.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("pool_options"),
+				"Pool options cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = mapping.SetStaticPort(r.StaticPort.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("static_port"),
+			"Static port cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = mapping.SetNoNAT(r.NoNAT.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("no_nat"),
+			"No NAT cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = mapping.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = mapping.SetDisabled(r.Disabled.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &mapping, diags
+	}
+
+	err = mapping.Validate()
+	if err != nil {
+		diags.AddError(
+			"Outbound NAT mapping is invalid",
+			err.Error(),
+		)
+	}
+
+	return &mapping, diags
+}
+
+func (r *FirewallNATOutboundMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_outbound_mapping", req.ProviderTypeName)
+}
+
+func (r *FirewallNATOutboundMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall outbound NAT mapping, controls how traffic leaving an interface is translated. Mappings are only used when the outbound NAT mode is 'hybrid' or 'manual'.",
+		MarkdownDescription: "Firewall [outbound NAT](https://docs.netgate.com/pfsense/en/latest/nat/outbound.html) mapping, controls how traffic leaving an interface is translated. Mappings are only used when the outbound NAT mode is `hybrid` or `manual`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of mapping, kept in pfSense as a '[tf:<id>]' tag at the end of the description. Mappings created outside of the provider are identified by their creation time until updated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface on which the traffic leaves, for example 'wan'.",
+				MarkdownDescription: "Interface on which the traffic leaves, for example `wan`.",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("IP protocol to match, one of '%s', defaults to 'any'.", strings.Join(pfsense.NATOutboundProtocols, "', '")),
+				MarkdownDescription: fmt.Sprintf("IP protocol to match, one of `%s`, defaults to `any`.", strings.Join(pfsense.NATOutboundProtocols, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source_address": schema.StringAttribute{
+				Description:         fmt.Sprintf("Source to match, 'any', '%s' (this firewall), an IP address, CIDR, or alias.", pfsense.NATOutboundSourceSelf),
+				MarkdownDescription: fmt.Sprintf("Source to match, `any`, `%s` (this firewall), an IP address, CIDR, or alias.", pfsense.NATOutboundSourceSelf),
+				Required:            true,
+			},
+			"source_port": schema.StringAttribute{
+				Description:         "Source port, port range (e.g. '1024-2048'), or port alias to match, any port when unset.",
+				MarkdownDescription: "Source port, port range (e.g. `1024-2048`), or port alias to match, any port when unset.",
+				Optional:            true,
+			},
+			"destination_address": schema.StringAttribute{
+				Description:         "Destination to match, 'any', an IP address, CIDR, or alias, defaults to 'any'.",
+				MarkdownDescription: "Destination to match, `any`, an IP address, CIDR, or alias, defaults to `any`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination_port": schema.StringAttribute{
+				Description:         "Destination port, port range (e.g. '8000-8080'), or port alias to match, any port when unset.",
+				MarkdownDescription: "Destination port, port range (e.g. `8000-8080`), or port alias to match, any port when unset.",
+				Optional:            true,
+			},
+			"destination_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the destination match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the destination match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"translation_address": schema.StringAttribute{
+				Description: "Address to translate matching traffic to, an IP address (e.g. a virtual IP), CIDR pool, or alias, the interface address when unset.",
+				Optional:    true,
+			},
+			"translation_port": schema.StringAttribute{
+				Description: "Port or port alias to translate the source port to, randomized when unset.",
+				Optional:    true,
+			},
+			"pool_options": schema.StringAttribute{
+				Description:         fmt.Sprintf("Method of selecting an address when translating to a CIDR pool or alias, one of '%s', the system default when unset.", strings.Join(pfsense.NATOutboundPoolOptions, "', '")),
+				MarkdownDescription: fmt.Sprintf("Method of selecting an address when translating to a CIDR pool or alias, one of `%s`, the system default when unset.", strings.Join(pfsense.NATOutboundPoolOptions, "`, `")),
+				Optional:            true,
+			},
+			"static_port": schema.BoolAttribute{
+				Description:         "Keep the source port unchanged, defaults to 'false'.",
+				MarkdownDescription: "Keep the source port unchanged, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_nat": schema.BoolAttribute{
+				Description:         "Do not translate matching traffic, defaults to 'false'.",
+				MarkdownDescription: "Do not translate matching traffic, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable mapping, defaults to 'false'.",
+				MarkdownDescription: "Disable mapping, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"after": schema.StringAttribute{
+				Description:         "Identifier of the mapping this mapping is placed directly after, an empty string places it first. Mappings are evaluated in order, new mappings are appended when unset.",
+				MarkdownDescription: "Identifier of the mapping this mapping is placed directly after, an empty string places it first. Mappings are evaluated in order, new mappings are appended when unset.",
+				Optional:            true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATOutboundMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATOutboundMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATOutboundMappingResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappingReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.client.CreateFirewallNATOutboundMapping(ctx, *mappingReq)
+	if addError(&resp.Diagnostics, "Error creating outbound NAT mapping", err) {
+		return
+	}
+
+	if !data.After.IsNull() {
+		mapping, err = r.client.MoveFirewallNATOutboundMapping(ctx, mapping.ID, data.After.ValueString())
+		if addError(&resp.Diagnostics, "Error ordering outbound NAT mapping", err) {
+			return
+		}
+	}
+
+	diags = data.SetFromValue(ctx, mapping)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATOutboundMappingResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.client.GetFirewallNATOutboundMapping(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading outbound NAT mapping", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, mapping)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATOutboundMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATOutboundMappingResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappingReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.client.UpdateFirewallNATOutboundMapping(ctx, *mappingReq)
+	if addError(&resp.Diagnostics, "Error updating outbound NAT mapping", err) {
+		return
+	}
+
+	if !data.After.IsNull() {
+		mapping, err = r.client.MoveFirewallNATOutboundMapping(ctx, mapping.ID, data.After.ValueString())
+		if addError(&resp.Diagnostics, "Error ordering outbound NAT mapping", err) {
+			return
+		}
+	}
+
+	diags = data.SetFromValue(ctx, mapping)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallNATOutboundMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallNATOutboundMapping(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting outbound NAT mapping", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT mapping", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
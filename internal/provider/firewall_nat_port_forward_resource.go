@@ -0,0 +1,487 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATPortForwardResource{}
+var _ resource.ResourceWithImportState = &FirewallNATPortForwardResource{}
+
+func NewFirewallNATPortForwardResource() resource.Resource {
+	return &FirewallNATPortForwardResource{}
+}
+
+type FirewallNATPortForwardResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATPortForwardResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Interface             types.String `tfsdk:"interface"`
+	Protocol              types.String `tfsdk:"protocol"`
+	SourceAddress         types.String `tfsdk:"source_address"`
+	SourcePort            types.String `tfsdk:"source_port"`
+	DestinationAddress    types.String `tfsdk:"destination_address"`
+	DestinationPort       types.String `tfsdk:"destination_port"`
+	RedirectTargetIP      types.String `tfsdk:"redirect_target_ip"`
+	RedirectTargetPort    types.String `tfsdk:"redirect_target_port"`
+	Description           types.String `tfsdk:"description"`
+	NATReflection         types.String `tfsdk:"nat_reflection"`
+	FilterRuleAssociation types.String `tfsdk:"filter_rule_association"`
+	SourceInvert          types.Bool   `tfsdk:"source_invert"`
+	DestinationInvert     types.Bool   `tfsdk:"destination_invert"`
+	Disabled              types.Bool   `tfsdk:"disabled"`
+	NoRDR                 types.Bool   `tfsdk:"no_rdr"`
+	Apply                 types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATPortForwardResourceModel) SetFromValue(ctx context.Context, portForward *pfsense.FirewallNATPortForward) diag.Diagnostics {
+	r.ID = types.StringValue(portForward.ID)
+	r.Interface = types.StringValue(portForward.Interface)
+	r.Protocol = types.StringValue(portForward.Protocol)
+	r.SourceAddress = types.StringValue(portForward.SourceAddress)
+	r.SourceInvert = types.BoolValue(portForward.SourceInvert)
+	r.DestinationAddress = types.StringValue(portForward.DestinationAddress)
+	r.DestinationInvert = types.BoolValue(portForward.DestinationInvert)
+	r.RedirectTargetIP = types.StringValue(portForward.RedirectTargetIP)
+	r.NATReflection = types.StringValue(portForward.NATReflection)
+	r.FilterRuleAssociation = types.StringValue(portForward.FilterRuleAssociation)
+	r.Disabled = types.BoolValue(portForward.Disabled)
+	r.NoRDR = types.BoolValue(portForward.NoRDR)
+
+	if portForward.SourcePort != "" {
+		r.SourcePort = types.StringValue(portForward.SourcePort)
+	}
+
+	if portForward.DestinationPort != "" {
+		r.DestinationPort = types.StringValue(portForward.DestinationPort)
+	}
+
+	if portForward.RedirectTargetPort != "" {
+		r.RedirectTargetPort = types.StringValue(portForward.RedirectTargetPort)
+	}
+
+	if portForward.Description != "" {
+		r.Description = types.StringValue(portForward.Description)
+	}
+
+	return nil
+}
+
+func (r FirewallNATPortForwardResourceModel) Value(ctx context.Context) (*pfsense.FirewallNATPortForward, diag.Diagnostics) {
+	var portForward pfsense.FirewallNATPortForward
+	var err error
+	var diags diag.Diagnostics
+
+	portForward.ID = r.ID.ValueString()
+
+	err = portForward.SetInterface(r.Interface.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetProtocol(r.Protocol.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetSourceAddress(r.SourceAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_address"),
+			"Source address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.SourcePort.IsNull() {
+		err = portForward.SetSourcePort(r.SourcePort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source_port"),
+				"Source port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = portForward.SetSourceInvert(r.SourceInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_invert"),
+			"Source invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetDestinationAddress(r.DestinationAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_address"),
+			"Destination address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.DestinationPort.IsNull() {
+		err = portForward.SetDestinationPort(r.DestinationPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("destination_port"),
+				"Destination port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = portForward.SetDestinationInvert(r.DestinationInvert.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_invert"),
+			"Destination invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetRedirectTargetIP(r.RedirectTargetIP.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("redirect_target_ip"),
+			"Redirect target IP cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.RedirectTargetPort.IsNull() {
+		err = portForward.SetRedirectTargetPort(r.RedirectTargetPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("redirect_target_port"),
+				"Redirect target port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Description.IsNull() {
+		err = portForward.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = portForward.SetDisabled(r.Disabled.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetNoRDR(r.NoRDR.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("no_rdr"),
+			"No RDR cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetNATReflection(r.NATReflection.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("nat_reflection"),
+			"NAT reflection cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = portForward.SetFilterRuleAssociation(r.FilterRuleAssociation.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("filter_rule_association"),
+			"Filter rule association cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &portForward, diags
+}
+
+func (r *FirewallNATPortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_port_forward", req.ProviderTypeName)
+}
+
+func (r *FirewallNATPortForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall NAT port forward, redirects traffic arriving on an interface to an internal host.",
+		MarkdownDescription: "Firewall NAT [port forward](https://docs.netgate.com/pfsense/en/latest/nat/port-forwards.html), redirects traffic arriving on an interface to an internal host.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of port forward, kept in pfSense as a '[tf:<id>]' tag at the end of the description. Port forwards created outside of the provider are identified by their creation time until updated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface on which the traffic arrives, for example 'wan'.",
+				MarkdownDescription: "Interface on which the traffic arrives, for example `wan`.",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("IP protocol to match, one of '%s', defaults to 'tcp'.", strings.Join(pfsense.NATProtocols, "', '")),
+				MarkdownDescription: fmt.Sprintf("IP protocol to match, one of `%s`, defaults to `tcp`.", strings.Join(pfsense.NATProtocols, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("tcp"),
+			},
+			"source_address": schema.StringAttribute{
+				Description:         "Source to match, 'any', an IP address, CIDR, alias, or interface keyword (e.g. 'lan' for the LAN network), defaults to 'any'.",
+				MarkdownDescription: "Source to match, `any`, an IP address, CIDR, alias, or interface keyword (e.g. `lan` for the LAN network), defaults to `any`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source_port": schema.StringAttribute{
+				Description:         "Source port, port range (e.g. '1024-2048'), or port alias to match, any port when unset.",
+				MarkdownDescription: "Source port, port range (e.g. `1024-2048`), or port alias to match, any port when unset.",
+				Optional:            true,
+			},
+			"source_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the source match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the source match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"destination_address": schema.StringAttribute{
+				Description:         "Destination to match, 'any', an IP address, CIDR, alias, or interface keyword (e.g. 'wanip' for the WAN address), defaults to 'wanip'.",
+				MarkdownDescription: "Destination to match, `any`, an IP address, CIDR, alias, or interface keyword (e.g. `wanip` for the WAN address), defaults to `wanip`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("wanip"),
+			},
+			"destination_port": schema.StringAttribute{
+				Description:         "Destination port, port range (e.g. '8000-8080'), or port alias to match.",
+				MarkdownDescription: "Destination port, port range (e.g. `8000-8080`), or port alias to match.",
+				Optional:            true,
+			},
+			"destination_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the destination match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the destination match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"redirect_target_ip": schema.StringAttribute{
+				Description: "Internal IP address or host alias to redirect traffic to.",
+				Required:    true,
+			},
+			"redirect_target_port": schema.StringAttribute{
+				Description: "Internal port or port alias to redirect traffic to, the start of the range when a destination port range is used.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable port forward, defaults to 'false'.",
+				MarkdownDescription: "Disable port forward, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_rdr": schema.BoolAttribute{
+				Description:         "Disable redirection for traffic matching this rule (no RDR), defaults to 'false'.",
+				MarkdownDescription: "Disable redirection for traffic matching this rule (no RDR), defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nat_reflection": schema.StringAttribute{
+				Description: fmt.Sprintf("NAT reflection mode, one of '%s' (use system setting), '%s' (NAT + proxy), '%s', or '%s', defaults to '%s'.",
+					pfsense.NATReflectionDefault, pfsense.NATReflectionEnable, pfsense.NATReflectionPureNAT, pfsense.NATReflectionDisable, pfsense.NATReflectionDefault),
+				MarkdownDescription: fmt.Sprintf("NAT reflection mode, one of `%s` (use system setting), `%s` (NAT + proxy), `%s`, or `%s`, defaults to `%s`.",
+					pfsense.NATReflectionDefault, pfsense.NATReflectionEnable, pfsense.NATReflectionPureNAT, pfsense.NATReflectionDisable, pfsense.NATReflectionDefault),
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(pfsense.NATReflectionDefault),
+			},
+			"filter_rule_association": schema.StringAttribute{
+				Description: fmt.Sprintf("Filter rule association, one of '%s', '%s' (pass traffic without a filter rule), or '%s' (filter rule maintained alongside the port forward), defaults to '%s'.",
+					pfsense.NATFilterRuleAssociationNone, pfsense.NATFilterRuleAssociationPass, pfsense.NATFilterRuleAssociationLinked, pfsense.NATFilterRuleAssociationLinked),
+				MarkdownDescription: fmt.Sprintf("Filter rule association, one of `%s`, `%s` (pass traffic without a filter rule), or `%s` (filter rule maintained alongside the port forward), defaults to `%s`.",
+					pfsense.NATFilterRuleAssociationNone, pfsense.NATFilterRuleAssociationPass, pfsense.NATFilterRuleAssociationLinked, pfsense.NATFilterRuleAssociationLinked),
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(pfsense.NATFilterRuleAssociationLinked),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATPortForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATPortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portForwardReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portForward, err := r.client.CreateFirewallNATPortForward(ctx, *portForwardReq)
+	if addError(&resp.Diagnostics, "Error creating port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, portForward)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATPortForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portForward, err := r.client.GetFirewallNATPortForward(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, portForward)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATPortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portForwardReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portForward, err := r.client.UpdateFirewallNATPortForward(ctx, *portForwardReq)
+	if addError(&resp.Diagnostics, "Error updating port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, portForward)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATPortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallNATPortForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallNATPortForward(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting port forward", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATPortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
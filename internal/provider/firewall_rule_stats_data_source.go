@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallRuleStatsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallRuleStatsDataSource{}
+)
+
+func NewFirewallRuleStatsDataSource() datasource.DataSource {
+	return &FirewallRuleStatsDataSource{}
+}
+
+type FirewallRuleStatsDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallRuleStatsDataSourceModel struct {
+	Tracker types.String `tfsdk:"tracker"`
+	All     types.List   `tfsdk:"all"`
+}
+
+type FirewallRuleStatsModel struct {
+	Number      types.Int64  `tfsdk:"number"`
+	Rule        types.String `tfsdk:"rule"`
+	Tracker     types.String `tfsdk:"tracker"`
+	Description types.String `tfsdk:"description"`
+	Evaluations types.Int64  `tfsdk:"evaluations"`
+	Packets     types.Int64  `tfsdk:"packets"`
+	Bytes       types.Int64  `tfsdk:"bytes"`
+	States      types.Int64  `tfsdk:"states"`
+}
+
+func (m FirewallRuleStatsModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"number":      types.Int64Type,
+		"rule":        types.StringType,
+		"tracker":     types.StringType,
+		"description": types.StringType,
+		"evaluations": types.Int64Type,
+		"packets":     types.Int64Type,
+		"bytes":       types.Int64Type,
+		"states":      types.Int64Type,
+	}}
+}
+
+func (m *FirewallRuleStatsModel) SetFromValue(ctx context.Context, stats *pfsense.FirewallRuleStats) diag.Diagnostics {
+	m.Number = types.Int64Value(int64(stats.Number))
+	m.Rule = types.StringValue(stats.Rule)
+	m.Evaluations = types.Int64Value(int64(stats.Evaluations))
+	m.Packets = types.Int64Value(int64(stats.Packets))
+	m.Bytes = types.Int64Value(int64(stats.Bytes))
+	m.States = types.Int64Value(int64(stats.States))
+
+	m.Tracker = types.StringNull()
+	if stats.Tracker != "" {
+		m.Tracker = types.StringValue(stats.Tracker)
+	}
+
+	m.Description = types.StringNull()
+	if stats.Description != "" {
+		m.Description = types.StringValue(stats.Description)
+	}
+
+	return nil
+}
+
+func (d *FirewallRuleStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_rule_stats", req.ProviderTypeName)
+}
+
+func (d *FirewallRuleStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves evaluation, packet, byte, and state counters of loaded firewall rules, for example to find rules which never match. Counters reset when the ruleset is reloaded.",
+		Attributes: map[string]schema.Attribute{
+			"tracker": schema.StringAttribute{
+				Description: "Only return rules with this tracker ID.",
+				Optional:    true,
+			},
+			"all": schema.ListNestedAttribute{
+				Description: "All matching rules, in ruleset order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"number": schema.Int64Attribute{
+							Description: "Rule number in the loaded ruleset.",
+							Computed:    true,
+						},
+						"rule": schema.StringAttribute{
+							Description: "Rule as loaded into pf.",
+							Computed:    true,
+						},
+						"tracker": schema.StringAttribute{
+							Description: "Tracker ID of the rule, null for rules without one.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the configured rule, or the rule label for generated rules.",
+							Computed:    true,
+						},
+						"evaluations": schema.Int64Attribute{
+							Description: "Number of times the rule was evaluated.",
+							Computed:    true,
+						},
+						"packets": schema.Int64Attribute{
+							Description: "Number of packets matched.",
+							Computed:    true,
+						},
+						"bytes": schema.Int64Attribute{
+							Description: "Number of bytes matched.",
+							Computed:    true,
+						},
+						"states": schema.Int64Attribute{
+							Description: "Number of current states created by the rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallRuleStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallRuleStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallRuleStatsDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := d.client.GetFirewallRuleStats(ctx)
+	if addError(&resp.Diagnostics, "Unable to get rule stats", err) {
+		return
+	}
+
+	if !data.Tracker.IsNull() {
+		filtered := stats.GetByTracker(data.Tracker.ValueString())
+		stats = &filtered
+	}
+
+	statsModels := []FirewallRuleStatsModel{}
+	for _, s := range *stats {
+		var statsModel FirewallRuleStatsModel
+		s := s
+		diags = statsModel.SetFromValue(ctx, &s)
+		resp.Diagnostics.Append(diags...)
+		statsModels = append(statsModels, statsModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, FirewallRuleStatsModel{}.GetAttrType(), statsModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
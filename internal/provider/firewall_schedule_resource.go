@@ -0,0 +1,501 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallScheduleResource{}
+var _ resource.ResourceWithImportState = &FirewallScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallScheduleResource{}
+
+func NewFirewallScheduleResource() resource.Resource {
+	return &FirewallScheduleResource{}
+}
+
+type FirewallScheduleResource struct {
+	client *pfsense.Client
+}
+
+type FirewallScheduleResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	TimeRanges  types.List   `tfsdk:"time_ranges"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type FirewallScheduleTimeRangeModel struct {
+	DaysOfWeek  types.Set    `tfsdk:"days_of_week"`
+	Dates       types.Set    `tfsdk:"dates"`
+	StartTime   types.String `tfsdk:"start_time"`
+	StopTime    types.String `tfsdk:"stop_time"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r FirewallScheduleTimeRangeModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"days_of_week": types.SetType{ElemType: types.StringType},
+		"dates":        types.SetType{ElemType: types.StringType},
+		"start_time":   types.StringType,
+		"stop_time":    types.StringType,
+		"description":  types.StringType,
+	}}
+}
+
+func (r *FirewallScheduleTimeRangeModel) SetFromValue(ctx context.Context, timeRange *pfsense.FirewallScheduleTimeRange) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	r.DaysOfWeek = types.SetNull(types.StringType)
+	r.Dates = types.SetNull(types.StringType)
+
+	if len(timeRange.Weekdays) != 0 {
+		r.DaysOfWeek, d = types.SetValueFrom(ctx, types.StringType, timeRange.Weekdays)
+		diags.Append(d...)
+	}
+
+	if len(timeRange.Dates) != 0 {
+		var dates []string
+		for _, date := range timeRange.Dates {
+			dates = append(dates, date.String())
+		}
+
+		r.Dates, d = types.SetValueFrom(ctx, types.StringType, dates)
+		diags.Append(d...)
+	}
+
+	r.StartTime = types.StringValue(timeRange.StartTime)
+	r.StopTime = types.StringValue(timeRange.StopTime)
+
+	if timeRange.Description != "" {
+		r.Description = types.StringValue(timeRange.Description)
+	}
+
+	return diags
+}
+
+func (r *FirewallScheduleResourceModel) SetFromValue(ctx context.Context, schedule *pfsense.FirewallSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(schedule.Name)
+
+	if schedule.Description != "" {
+		r.Description = types.StringValue(schedule.Description)
+	}
+
+	timeRanges := []FirewallScheduleTimeRangeModel{}
+	for _, timeRange := range schedule.TimeRanges {
+		var timeRangeModel FirewallScheduleTimeRangeModel
+		timeRange := timeRange
+		diags.Append(timeRangeModel.SetFromValue(ctx, &timeRange)...)
+		timeRanges = append(timeRanges, timeRangeModel)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	r.TimeRanges, d = types.ListValueFrom(ctx, FirewallScheduleTimeRangeModel{}.GetAttrType(), timeRanges)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r FirewallScheduleResourceModel) Value(ctx context.Context) (*pfsense.FirewallSchedule, diag.Diagnostics) {
+	var schedule pfsense.FirewallSchedule
+	var err error
+	var diags diag.Diagnostics
+
+	var timeRangeModels []*FirewallScheduleTimeRangeModel
+	diags = r.TimeRanges.ElementsAs(ctx, &timeRangeModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = schedule.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = schedule.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	for i, timeRangeModel := range timeRangeModels {
+		timeRange, d := timeRangeModel.Value(ctx, path.Root("time_ranges").AtListIndex(i))
+		diags.Append(d...)
+
+		if timeRange != nil {
+			schedule.TimeRanges = append(schedule.TimeRanges, *timeRange)
+		}
+	}
+
+	return &schedule, diags
+}
+
+func (r FirewallScheduleTimeRangeModel) Value(ctx context.Context, p path.Path) (*pfsense.FirewallScheduleTimeRange, diag.Diagnostics) {
+	var timeRange pfsense.FirewallScheduleTimeRange
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.DaysOfWeek.IsNull() {
+		var weekdays []string
+		diags.Append(r.DaysOfWeek.ElementsAs(ctx, &weekdays, false)...)
+
+		err = timeRange.SetWeekdays(weekdays)
+
+		if err != nil {
+			diags.AddAttributeError(
+				p.AtName("days_of_week"),
+				"Days of week cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Dates.IsNull() {
+		var dates []string
+		diags.Append(r.Dates.ElementsAs(ctx, &dates, false)...)
+
+		err = timeRange.SetDates(dates)
+
+		if err != nil {
+			diags.AddAttributeError(
+				p.AtName("dates"),
+				"Dates cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = timeRange.SetStartTime(r.StartTime.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			p.AtName("start_time"),
+			"Start time cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = timeRange.SetStopTime(r.StopTime.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			p.AtName("stop_time"),
+			"Stop time cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = timeRange.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				p.AtName("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = timeRange.Validate()
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Time range is not valid",
+			err.Error(),
+		)
+	}
+
+	return &timeRange, diags
+}
+
+// known reports whether every value needed to validate the time range is known.
+func (r FirewallScheduleTimeRangeModel) known() bool {
+	if r.DaysOfWeek.IsUnknown() || r.Dates.IsUnknown() || r.StartTime.IsUnknown() || r.StopTime.IsUnknown() {
+		return false
+	}
+
+	for _, set := range []types.Set{r.DaysOfWeek, r.Dates} {
+		for _, element := range set.Elements() {
+			if element.IsUnknown() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (r *FirewallScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_schedule", req.ProviderTypeName)
+}
+
+func (r *FirewallScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall schedule, defines when time based filter rules are active.",
+		MarkdownDescription: "Firewall [schedule](https://docs.netgate.com/pfsense/en/latest/firewall/time-based-rules.html), defines when time based filter rules are active.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of schedule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"time_ranges": schema.ListNestedAttribute{
+				Description: "Time ranges during which the schedule is active.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"days_of_week": schema.SetAttribute{
+							Description:         fmt.Sprintf("Days of the week on which the range repeats, any of '%s'. Conflicts with dates.", strings.Join(pfsense.FirewallScheduleWeekdays, "', '")),
+							MarkdownDescription: fmt.Sprintf("Days of the week on which the range repeats, any of `%s`. Conflicts with `dates`.", strings.Join(pfsense.FirewallScheduleWeekdays, "`, `")),
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"dates": schema.SetAttribute{
+							Description:         "Specific dates of the current year, in 'MM-DD' format. Conflicts with days of week.",
+							MarkdownDescription: "Specific dates of the current year, in `MM-DD` format. Conflicts with `days_of_week`.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"start_time": schema.StringAttribute{
+							Description:         "Start time, in 24 hour 'HH:MM' format.",
+							MarkdownDescription: "Start time, in 24 hour `HH:MM` format.",
+							Required:            true,
+						},
+						"stop_time": schema.StringAttribute{
+							Description:         "Stop time, in 24 hour 'HH:MM' format. Use '23:59' for the end of the day.",
+							MarkdownDescription: "Stop time, in 24 hour `HH:MM` format. Use `23:59` for the end of the day.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.TimeRanges.IsNull() || data.TimeRanges.IsUnknown() {
+		return
+	}
+
+	var timeRangeObjects []types.Object
+	resp.Diagnostics.Append(data.TimeRanges.ElementsAs(ctx, &timeRangeObjects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(timeRangeObjects) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time_ranges"),
+			"Time ranges cannot be empty",
+			"At least one time range is required.",
+		)
+		return
+	}
+
+	for i, timeRangeObject := range timeRangeObjects {
+		if timeRangeObject.IsNull() || timeRangeObject.IsUnknown() {
+			continue
+		}
+
+		var timeRangeModel FirewallScheduleTimeRangeModel
+		resp.Diagnostics.Append(timeRangeObject.As(ctx, &timeRangeModel, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !timeRangeModel.known() {
+			continue
+		}
+
+		_, diags := timeRangeModel.Value(ctx, path.Root("time_ranges").AtListIndex(i))
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r *FirewallScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.CreateFirewallSchedule(ctx, *scheduleReq)
+	if addError(&resp.Diagnostics, "Error creating schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying schedule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetFirewallSchedule(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.UpdateFirewallSchedule(ctx, *scheduleReq)
+	if addError(&resp.Diagnostics, "Error updating schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying schedule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallSchedule(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting schedule", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying schedule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
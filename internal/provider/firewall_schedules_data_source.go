@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallSchedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallSchedulesDataSource{}
+)
+
+func NewFirewallSchedulesDataSource() datasource.DataSource {
+	return &FirewallSchedulesDataSource{}
+}
+
+type FirewallSchedulesDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallSchedulesDataSourceModel struct {
+	All types.List `tfsdk:"all"`
+}
+
+type FirewallScheduleDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Active      types.Bool   `tfsdk:"active"`
+	TimeRanges  types.List   `tfsdk:"time_ranges"`
+}
+
+func (d FirewallScheduleDataSourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"active":      types.BoolType,
+		"time_ranges": types.ListType{ElemType: FirewallScheduleTimeRangeModel{}.GetAttrType()},
+	}}
+}
+
+func (d *FirewallScheduleDataSourceModel) SetFromValue(ctx context.Context, schedule *pfsense.FirewallSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Name = types.StringValue(schedule.Name)
+	d.Active = types.BoolValue(schedule.Active)
+
+	if schedule.Description != "" {
+		d.Description = types.StringValue(schedule.Description)
+	}
+
+	timeRanges := []FirewallScheduleTimeRangeModel{}
+	for _, timeRange := range schedule.TimeRanges {
+		var timeRangeModel FirewallScheduleTimeRangeModel
+		timeRange := timeRange
+		diags.Append(timeRangeModel.SetFromValue(ctx, &timeRange)...)
+		timeRanges = append(timeRanges, timeRangeModel)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var listDiags diag.Diagnostics
+	d.TimeRanges, listDiags = types.ListValueFrom(ctx, FirewallScheduleTimeRangeModel{}.GetAttrType(), timeRanges)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+func (d *FirewallSchedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_schedules", req.ProviderTypeName)
+}
+
+func (d *FirewallSchedulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves all firewall schedules and whether each is currently active.",
+		MarkdownDescription: "Retrieves all firewall [schedules](https://docs.netgate.com/pfsense/en/latest/firewall/time-based-rules.html) and whether each is currently active.",
+		Attributes: map[string]schema.Attribute{
+			"all": schema.ListNestedAttribute{
+				Description: "All schedules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of schedule.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Schedule is active at the current time on pfSense.",
+							Computed:    true,
+						},
+						"time_ranges": schema.ListNestedAttribute{
+							Description: "Time ranges during which the schedule is active.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"days_of_week": schema.SetAttribute{
+										Description: "Days of the week on which the range repeats.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"dates": schema.SetAttribute{
+										Description:         "Specific dates of the current year, in 'MM-DD' format.",
+										MarkdownDescription: "Specific dates of the current year, in `MM-DD` format.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"start_time": schema.StringAttribute{
+										Description:         "Start time, in 24 hour 'HH:MM' format.",
+										MarkdownDescription: "Start time, in 24 hour `HH:MM` format.",
+										Computed:            true,
+									},
+									"stop_time": schema.StringAttribute{
+										Description:         "Stop time, in 24 hour 'HH:MM' format.",
+										MarkdownDescription: "Stop time, in 24 hour `HH:MM` format.",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										Description: "For administrative reference (not parsed).",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallSchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallSchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallSchedulesDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := d.client.GetFirewallSchedules(ctx)
+	if addError(&resp.Diagnostics, "Unable to get schedules", err) {
+		return
+	}
+
+	scheduleModels := []FirewallScheduleDataSourceModel{}
+	for _, schedule := range *schedules {
+		var scheduleModel FirewallScheduleDataSourceModel
+		schedule := schedule
+		diags = scheduleModel.SetFromValue(ctx, &schedule)
+		resp.Diagnostics.Append(diags...)
+		scheduleModels = append(scheduleModels, scheduleModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, FirewallScheduleDataSourceModel{}.GetAttrType(), scheduleModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,482 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallShaperQueueResource{}
+var _ resource.ResourceWithImportState = &FirewallShaperQueueResource{}
+
+func NewFirewallShaperQueueResource() resource.Resource {
+	return &FirewallShaperQueueResource{}
+}
+
+type FirewallShaperQueueResource struct {
+	client *pfsense.Client
+}
+
+type FirewallShaperQueueResourceModel struct {
+	Interface     types.String `tfsdk:"interface"`
+	Name          types.String `tfsdk:"name"`
+	Parent        types.String `tfsdk:"parent"`
+	Description   types.String `tfsdk:"description"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	Bandwidth     types.Int64  `tfsdk:"bandwidth"`
+	BandwidthUnit types.String `tfsdk:"bandwidth_unit"`
+	QueueLength   types.Int64  `tfsdk:"queue_length"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Default       types.Bool   `tfsdk:"default"`
+	RED           types.Bool   `tfsdk:"red"`
+	RIO           types.Bool   `tfsdk:"rio"`
+	ECN           types.Bool   `tfsdk:"ecn"`
+	CoDel         types.Bool   `tfsdk:"codel"`
+	Apply         types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallShaperQueueResourceModel) SetFromValue(ctx context.Context, queue *pfsense.FirewallShaperQueue) diag.Diagnostics {
+	r.Interface = types.StringValue(queue.Interface)
+	r.Name = types.StringValue(queue.Name)
+	r.Priority = optionalInt64Value(queue.Priority)
+	r.QueueLength = optionalInt64Value(queue.QueueLength)
+	r.Enabled = types.BoolValue(queue.Enabled)
+	r.Default = types.BoolValue(queue.Default)
+	r.RED = types.BoolValue(queue.RED)
+	r.RIO = types.BoolValue(queue.RIO)
+	r.ECN = types.BoolValue(queue.ECN)
+	r.CoDel = types.BoolValue(queue.CoDel)
+
+	if queue.Parent != "" {
+		r.Parent = types.StringValue(queue.Parent)
+	}
+
+	if queue.Description != "" {
+		r.Description = types.StringValue(queue.Description)
+	}
+
+	r.Bandwidth = types.Int64Null()
+	if queue.Bandwidth != nil {
+		r.Bandwidth = types.Int64Value(int64(queue.Bandwidth.Bandwidth))
+		r.BandwidthUnit = types.StringValue(queue.Bandwidth.Unit)
+	} else if r.BandwidthUnit.IsNull() {
+		r.BandwidthUnit = types.StringValue(pfsense.FirewallShaperBandwidthUnitPercent)
+	}
+
+	return nil
+}
+
+func (r FirewallShaperQueueResourceModel) Value(ctx context.Context) (*pfsense.FirewallShaperQueue, diag.Diagnostics) {
+	var queue pfsense.FirewallShaperQueue
+	var err error
+	var diags diag.Diagnostics
+
+	err = queue.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Parent.IsNull() {
+		err = queue.SetParent(r.Parent.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("parent"),
+				"Parent cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Description.IsNull() {
+		err = queue.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Priority.IsNull() {
+		err = queue.SetPriority(int(r.Priority.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("priority"),
+				"Priority cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Bandwidth.IsNull() {
+		bandwidth, d := firewallShaperBandwidthValue(r.Bandwidth, r.BandwidthUnit)
+		diags.Append(d...)
+
+		if bandwidth != nil {
+			err = queue.SetBandwidth(*bandwidth)
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("bandwidth"),
+					"Bandwidth cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	if !r.QueueLength.IsNull() {
+		err = queue.SetQueueLength(int(r.QueueLength.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("queue_length"),
+				"Queue length cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = queue.SetEnabled(r.Enabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetDefault(r.Default.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("default"),
+			"Default cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetRED(r.RED.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("red"),
+			"RED cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetRIO(r.RIO.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("rio"),
+			"RIO cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetECN(r.ECN.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ecn"),
+			"ECN cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = queue.SetCoDel(r.CoDel.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("codel"),
+			"CoDel cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &queue, diags
+}
+
+func (r *FirewallShaperQueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_shaper_queue", req.ProviderTypeName)
+}
+
+func (r *FirewallShaperQueueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall ALTQ traffic shaper queue, filter rules assign traffic (including ACK packets) to queues. Child queue bandwidths must fit within their parent, absolute bandwidths under a parent sized as a percentage are only checked by pfSense when the queue is saved.",
+		MarkdownDescription: "Firewall [ALTQ traffic shaper](https://docs.netgate.com/pfsense/en/latest/trafficshaper/altq.html) queue, filter rules assign traffic (including ACK packets) to queues. Child queue bandwidths must fit within their parent, absolute bandwidths under a parent sized as a percentage are only checked by pfSense when the queue is saved.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Interface of the shaper the queue belongs to, for example 'wan'.",
+				MarkdownDescription: "Interface of the shaper the queue belongs to, for example `wan`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of queue, must be unique on the interface.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent": schema.StringAttribute{
+				Description:         fmt.Sprintf("Name of the parent queue, the queue is attached to the interface when unset. Only supported by the '%s' and '%s' schedulers.", pfsense.FirewallShaperSchedulerHFSC, pfsense.FirewallShaperSchedulerCBQ),
+				MarkdownDescription: fmt.Sprintf("Name of the parent queue, the queue is attached to the interface when unset. Only supported by the `%s` and `%s` schedulers.", pfsense.FirewallShaperSchedulerHFSC, pfsense.FirewallShaperSchedulerCBQ),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"priority": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Priority, higher values are serviced first (0-15, 0-7 for '%s').", pfsense.FirewallShaperSchedulerCBQ),
+				MarkdownDescription: fmt.Sprintf("Priority, higher values are serviced first (0-15, 0-7 for `%s`).", pfsense.FirewallShaperSchedulerCBQ),
+				Optional:            true,
+			},
+			"bandwidth": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Share of the parent bandwidth, not supported by the '%s' scheduler.", pfsense.FirewallShaperSchedulerPRIQ),
+				MarkdownDescription: fmt.Sprintf("Share of the parent bandwidth, not supported by the `%s` scheduler.", pfsense.FirewallShaperSchedulerPRIQ),
+				Optional:            true,
+			},
+			"bandwidth_unit": schema.StringAttribute{
+				Description:         fmt.Sprintf("Bandwidth unit, one of '%s', defaults to '%s'. A percentage is of the parent bandwidth.", strings.Join(pfsense.FirewallShaperBandwidthUnits, "', '"), pfsense.FirewallShaperBandwidthUnitPercent),
+				MarkdownDescription: fmt.Sprintf("Bandwidth unit, one of `%s`, defaults to `%s`. A percentage is of the parent bandwidth.", strings.Join(pfsense.FirewallShaperBandwidthUnits, "`, `"), pfsense.FirewallShaperBandwidthUnitPercent),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(pfsense.FirewallShaperBandwidthUnitPercent),
+			},
+			"queue_length": schema.Int64Attribute{
+				Description: "Number of packets that can be queued before they are dropped.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Enable queue, defaults to 'true'.",
+				MarkdownDescription: "Enable queue, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"default": schema.BoolAttribute{
+				Description:         "Queue for traffic not assigned to another queue, each interface requires exactly one, defaults to 'false'.",
+				MarkdownDescription: "Queue for traffic not assigned to another queue, each interface requires exactly one, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"red": schema.BoolAttribute{
+				Description:         "Enable random early detection, defaults to 'false'.",
+				MarkdownDescription: "Enable random early detection, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"rio": schema.BoolAttribute{
+				Description:         "Enable random early detection in and out, defaults to 'false'.",
+				MarkdownDescription: "Enable random early detection in and out, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ecn": schema.BoolAttribute{
+				Description:         "Enable explicit congestion notification, requires RED or RIO, defaults to 'false'.",
+				MarkdownDescription: "Enable explicit congestion notification, requires `red` or `rio`, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"codel": schema.BoolAttribute{
+				Description:         "Enable CoDel active queue management, defaults to 'false'.",
+				MarkdownDescription: "Enable CoDel active queue management, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallShaperQueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallShaperQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallShaperQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.CreateFirewallShaperQueue(ctx, *queueReq)
+	if addError(&resp.Diagnostics, "Error creating shaper queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallShaperQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.GetFirewallShaperQueue(ctx, data.Interface.ValueString(), data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading shaper queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallShaperQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallShaperQueueResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.UpdateFirewallShaperQueue(ctx, *queueReq)
+	if addError(&resp.Diagnostics, "Error updating shaper queue", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, queue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallShaperQueueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallShaperQueue(ctx, data.Interface.ValueString(), data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting shaper queue", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper queue", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	iface, name, found := strings.Cut(req.ID, "/")
+	if !found || iface == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format 'interface/name', got '%s'.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), iface)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+const firewallShaperDefaultBandwidthUnit = "Mb"
+
+var _ resource.Resource = &FirewallShaperResource{}
+var _ resource.ResourceWithImportState = &FirewallShaperResource{}
+
+func NewFirewallShaperResource() resource.Resource {
+	return &FirewallShaperResource{}
+}
+
+type FirewallShaperResource struct {
+	client *pfsense.Client
+}
+
+type FirewallShaperResourceModel struct {
+	Interface     types.String `tfsdk:"interface"`
+	Scheduler     types.String `tfsdk:"scheduler"`
+	Bandwidth     types.Int64  `tfsdk:"bandwidth"`
+	BandwidthUnit types.String `tfsdk:"bandwidth_unit"`
+	QueueLength   types.Int64  `tfsdk:"queue_length"`
+	TBRSize       types.Int64  `tfsdk:"tbr_size"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Apply         types.Bool   `tfsdk:"apply"`
+}
+
+// firewallShaperBandwidthValue builds a bandwidth from the bandwidth attributes shared by shapers and shaper queues.
+func firewallShaperBandwidthValue(b types.Int64, unit types.String) (*pfsense.FirewallShaperBandwidth, diag.Diagnostics) {
+	var bandwidth pfsense.FirewallShaperBandwidth
+	var err error
+	var diags diag.Diagnostics
+
+	err = bandwidth.SetBandwidth(int(b.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("bandwidth"),
+			"Bandwidth cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = bandwidth.SetUnit(unit.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("bandwidth_unit"),
+			"Bandwidth unit cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = bandwidth.Validate()
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("bandwidth"),
+			"Bandwidth is not valid",
+			err.Error(),
+		)
+	}
+
+	return &bandwidth, diags
+}
+
+func (r *FirewallShaperResourceModel) SetFromValue(ctx context.Context, shaper *pfsense.FirewallShaper) diag.Diagnostics {
+	r.Interface = types.StringValue(shaper.Interface)
+	r.Scheduler = types.StringValue(shaper.Scheduler)
+	r.QueueLength = optionalInt64Value(shaper.QueueLength)
+	r.TBRSize = optionalInt64Value(shaper.TBRSize)
+	r.Enabled = types.BoolValue(shaper.Enabled)
+
+	r.Bandwidth = types.Int64Null()
+	if shaper.Bandwidth != nil {
+		r.Bandwidth = types.Int64Value(int64(shaper.Bandwidth.Bandwidth))
+		r.BandwidthUnit = types.StringValue(shaper.Bandwidth.Unit)
+	} else if r.BandwidthUnit.IsNull() {
+		r.BandwidthUnit = types.StringValue(firewallShaperDefaultBandwidthUnit)
+	}
+
+	return nil
+}
+
+func (r FirewallShaperResourceModel) Value(ctx context.Context) (*pfsense.FirewallShaper, diag.Diagnostics) {
+	var shaper pfsense.FirewallShaper
+	var err error
+	var diags diag.Diagnostics
+
+	err = shaper.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = shaper.SetScheduler(r.Scheduler.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("scheduler"),
+			"Scheduler cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Bandwidth.IsNull() {
+		bandwidth, d := firewallShaperBandwidthValue(r.Bandwidth, r.BandwidthUnit)
+		diags.Append(d...)
+
+		if bandwidth != nil {
+			err = shaper.SetBandwidth(*bandwidth)
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("bandwidth"),
+					"Bandwidth cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	if !r.QueueLength.IsNull() {
+		err = shaper.SetQueueLength(int(r.QueueLength.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("queue_length"),
+				"Queue length cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.TBRSize.IsNull() {
+		err = shaper.SetTBRSize(int(r.TBRSize.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tbr_size"),
+				"Token bucket size cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = shaper.SetEnabled(r.Enabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &shaper, diags
+}
+
+func (r *FirewallShaperResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_shaper", req.ProviderTypeName)
+}
+
+func (r *FirewallShaperResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall ALTQ traffic shaper for an interface, the root of the interface queue tree.",
+		MarkdownDescription: "Firewall [ALTQ traffic shaper](https://docs.netgate.com/pfsense/en/latest/trafficshaper/altq.html) for an interface, the root of the interface queue tree.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Interface to shape, for example 'wan'.",
+				MarkdownDescription: "Interface to shape, for example `wan`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheduler": schema.StringAttribute{
+				Description:         fmt.Sprintf("Scheduler, one of '%s'. Cannot be changed while the interface has queues.", strings.Join(pfsense.FirewallShaperSchedulers, "', '")),
+				MarkdownDescription: fmt.Sprintf("Scheduler, one of `%s`. Cannot be changed while the interface has queues.", strings.Join(pfsense.FirewallShaperSchedulers, "`, `")),
+				Required:            true,
+			},
+			"bandwidth": schema.Int64Attribute{
+				Description: "Bandwidth available to the interface queues, pfSense uses the interface speed when unset.",
+				Optional:    true,
+			},
+			"bandwidth_unit": schema.StringAttribute{
+				Description:         fmt.Sprintf("Bandwidth unit, one of '%s', defaults to 'Mb'. A percentage is of the interface speed.", strings.Join(pfsense.FirewallShaperBandwidthUnits, "', '")),
+				MarkdownDescription: fmt.Sprintf("Bandwidth unit, one of `%s`, defaults to `Mb`. A percentage is of the interface speed.", strings.Join(pfsense.FirewallShaperBandwidthUnits, "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(firewallShaperDefaultBandwidthUnit),
+			},
+			"queue_length": schema.Int64Attribute{
+				Description: "Number of packets that can be queued before they are dropped.",
+				Optional:    true,
+			},
+			"tbr_size": schema.Int64Attribute{
+				Description: "Token bucket regulator size in bytes, usually left unset.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Enable shaping on the interface, defaults to 'true'.",
+				MarkdownDescription: "Enable shaping on the interface, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallShaperResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallShaperResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallShaperResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaperReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaper, err := r.client.CreateFirewallShaper(ctx, *shaperReq)
+	if addError(&resp.Diagnostics, "Error creating shaper", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, shaper)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallShaperResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaper, err := r.client.GetFirewallShaper(ctx, data.Interface.ValueString())
+	if addError(&resp.Diagnostics, "Error reading shaper", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, shaper)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallShaperResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallShaperResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaperReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shaper, err := r.client.UpdateFirewallShaper(ctx, *shaperReq)
+	if addError(&resp.Diagnostics, "Error updating shaper", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, shaper)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallShaperResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallShaper(ctx, data.Interface.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting shaper", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallShaperChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying shaper", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallShaperResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("interface"), req, resp)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallStateKillResource{}
+
+func NewFirewallStateKillResource() resource.Resource {
+	return &FirewallStateKillResource{}
+}
+
+type FirewallStateKillResource struct {
+	client *pfsense.Client
+}
+
+type FirewallStateKillResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Interface   types.String `tfsdk:"interface"`
+	Address     types.String `tfsdk:"address"`
+	Port        types.Int64  `tfsdk:"port"`
+	Killed      types.Int64  `tfsdk:"killed"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func (r *FirewallStateKillResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_state_kill", req.ProviderTypeName)
+}
+
+func (r *FirewallStateKillResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kill firewall states matching a filter on creation, or when the filter changes. States are killed by source and destination address, so all states between the matched hosts are removed. At least one of interface, address, or port is required.",
+		MarkdownDescription: "Kill firewall [states](https://docs.netgate.com/pfsense/en/latest/diagnostics/states.html) matching a filter on creation, or when the filter changes. " +
+			"States are killed by source and destination address, so all states between the matched hosts are removed. At least one of `interface`, `address`, or `port` is required.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "UUID for firewall state kill.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Kill states on this interface, for example 'wan'.",
+				MarkdownDescription: "Kill states on this interface, for example `wan`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "Kill states with this source or destination address, before or after NAT.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "Kill states with this source or destination port, before or after NAT.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"killed": schema.Int64Attribute{
+				Description: "Number of states matched when killed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Last updated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FirewallStateKillResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallStateKillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallStateKillResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := firewallStateFilterValue(data.Interface, data.Address, data.Port)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	killed, err := r.client.KillFirewallStates(ctx, *filter)
+	if addError(&resp.Diagnostics, "Error killing firewall states", err) {
+		return
+	}
+
+	data.ID = types.StringValue(uuid.New().String())
+	data.Killed = types.Int64Value(int64(killed))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallStateKillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *FirewallStateKillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *FirewallStateKillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallStatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallStatesDataSource{}
+)
+
+func NewFirewallStatesDataSource() datasource.DataSource {
+	return &FirewallStatesDataSource{}
+}
+
+type FirewallStatesDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallStatesDataSourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Address   types.String `tfsdk:"address"`
+	Port      types.Int64  `tfsdk:"port"`
+	All       types.List   `tfsdk:"all"`
+}
+
+type FirewallStateModel struct {
+	Interface                  types.String `tfsdk:"interface"`
+	Protocol                   types.String `tfsdk:"protocol"`
+	Direction                  types.String `tfsdk:"direction"`
+	SourceAddress              types.String `tfsdk:"source_address"`
+	SourcePort                 types.Int64  `tfsdk:"source_port"`
+	SourceOriginalAddress      types.String `tfsdk:"source_original_address"`
+	SourceOriginalPort         types.Int64  `tfsdk:"source_original_port"`
+	DestinationAddress         types.String `tfsdk:"destination_address"`
+	DestinationPort            types.Int64  `tfsdk:"destination_port"`
+	DestinationOriginalAddress types.String `tfsdk:"destination_original_address"`
+	DestinationOriginalPort    types.Int64  `tfsdk:"destination_original_port"`
+	State                      types.String `tfsdk:"state"`
+}
+
+func (m FirewallStateModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"interface":                    types.StringType,
+		"protocol":                     types.StringType,
+		"direction":                    types.StringType,
+		"source_address":               types.StringType,
+		"source_port":                  types.Int64Type,
+		"source_original_address":      types.StringType,
+		"source_original_port":         types.Int64Type,
+		"destination_address":          types.StringType,
+		"destination_port":             types.Int64Type,
+		"destination_original_address": types.StringType,
+		"destination_original_port":    types.Int64Type,
+		"state":                        types.StringType,
+	}}
+}
+
+func (m *FirewallStateModel) SetFromValue(ctx context.Context, state *pfsense.FirewallState) diag.Diagnostics {
+	m.Interface = types.StringValue(state.Interface)
+	m.Protocol = types.StringValue(state.Protocol)
+	m.Direction = types.StringValue(state.Direction)
+	m.SourceAddress = types.StringValue(state.Source.Address.String())
+	m.SourcePort = optionalInt64Value(state.Source.Port)
+	m.DestinationAddress = types.StringValue(state.Destination.Address.String())
+	m.DestinationPort = optionalInt64Value(state.Destination.Port)
+	m.State = types.StringValue(state.State)
+
+	m.SourceOriginalAddress = types.StringNull()
+	m.SourceOriginalPort = types.Int64Null()
+	if state.SourceOriginal != nil {
+		m.SourceOriginalAddress = types.StringValue(state.SourceOriginal.Address.String())
+		m.SourceOriginalPort = optionalInt64Value(state.SourceOriginal.Port)
+	}
+
+	m.DestinationOriginalAddress = types.StringNull()
+	m.DestinationOriginalPort = types.Int64Null()
+	if state.DestinationOriginal != nil {
+		m.DestinationOriginalAddress = types.StringValue(state.DestinationOriginal.Address.String())
+		m.DestinationOriginalPort = optionalInt64Value(state.DestinationOriginal.Port)
+	}
+
+	return nil
+}
+
+// firewallStateFilterValue builds a state filter from the optional interface, address, and port attributes.
+func firewallStateFilterValue(iface types.String, address types.String, port types.Int64) (*pfsense.FirewallStateFilter, diag.Diagnostics) {
+	var filter pfsense.FirewallStateFilter
+	var err error
+	var diags diag.Diagnostics
+
+	if !iface.IsNull() {
+		err = filter.SetInterface(iface.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("interface"),
+				"Interface cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !address.IsNull() {
+		err = filter.SetAddress(address.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("address"),
+				"Address cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !port.IsNull() {
+		err = filter.SetPort(int(port.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("port"),
+				"Port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &filter, diags
+}
+
+func (d *FirewallStatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_states", req.ProviderTypeName)
+}
+
+func (d *FirewallStatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves firewall states, optionally filtered by interface, address, and port.",
+		MarkdownDescription: "Retrieves firewall [states](https://docs.netgate.com/pfsense/en/latest/diagnostics/states.html), optionally filtered by interface, address, and port.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Only return states on this interface, for example 'wan'.",
+				MarkdownDescription: "Only return states on this interface, for example `wan`.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				Description: "Only return states with this source or destination address, before or after NAT.",
+				Optional:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Only return states with this source or destination port, before or after NAT.",
+				Optional:    true,
+			},
+			"all": schema.ListNestedAttribute{
+				Description: "All matching states.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							Description: "Interface of state.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Protocol of state.",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description:         "Direction of state, 'in' or 'out'.",
+							MarkdownDescription: "Direction of state, `in` or `out`.",
+							Computed:            true,
+						},
+						"source_address": schema.StringAttribute{
+							Description: "Source address.",
+							Computed:    true,
+						},
+						"source_port": schema.Int64Attribute{
+							Description: "Source port (or ICMP identifier).",
+							Computed:    true,
+						},
+						"source_original_address": schema.StringAttribute{
+							Description: "Source address before NAT, if translated.",
+							Computed:    true,
+						},
+						"source_original_port": schema.Int64Attribute{
+							Description: "Source port before NAT, if translated.",
+							Computed:    true,
+						},
+						"destination_address": schema.StringAttribute{
+							Description: "Destination address.",
+							Computed:    true,
+						},
+						"destination_port": schema.Int64Attribute{
+							Description: "Destination port.",
+							Computed:    true,
+						},
+						"destination_original_address": schema.StringAttribute{
+							Description: "Destination address before NAT, if translated.",
+							Computed:    true,
+						},
+						"destination_original_port": schema.Int64Attribute{
+							Description: "Destination port before NAT, if translated.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "Protocol state of each side, for example 'ESTABLISHED:ESTABLISHED'.",
+							MarkdownDescription: "Protocol state of each side, for example `ESTABLISHED:ESTABLISHED`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallStatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallStatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallStatesDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := firewallStateFilterValue(data.Interface, data.Address, data.Port)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	states, err := d.client.GetFirewallStates(ctx, *filter)
+	if addError(&resp.Diagnostics, "Unable to get states", err) {
+		return
+	}
+
+	stateModels := []FirewallStateModel{}
+	for _, state := range *states {
+		var stateModel FirewallStateModel
+		state := state
+		diags = stateModel.SetFromValue(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		stateModels = append(stateModels, stateModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, FirewallStateModel{}.GetAttrType(), stateModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallTableDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallTableDataSource{}
+)
+
+func NewFirewallTableDataSource() datasource.DataSource {
+	return &FirewallTableDataSource{}
+}
+
+type FirewallTableDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallTableDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Entries     types.List   `tfsdk:"entries"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func (d *FirewallTableDataSourceModel) SetFromValue(ctx context.Context, table *pfsense.FirewallTable) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Name = types.StringValue(table.Name)
+
+	entries := []string{}
+	for _, entry := range table.Entries {
+		entries = append(entries, entry.String())
+	}
+
+	d.Entries, diags = types.ListValueFrom(ctx, types.StringType, entries)
+
+	d.LastUpdated = types.StringNull()
+	if table.LastUpdated != nil {
+		d.LastUpdated = types.StringValue(table.LastUpdated.Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func (d *FirewallTableDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_table", req.ProviderTypeName)
+}
+
+func (d *FirewallTableDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves the current entries of a firewall (pf) table, for example the addresses an FQDN or URL table alias currently resolves to.",
+		MarkdownDescription: "Retrieves the current entries of a firewall (pf) [table](https://docs.netgate.com/pfsense/en/latest/diagnostics/tables.html), for example the addresses an FQDN or URL table alias currently resolves to.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Name of table, alias tables share the name of the alias, for example 'bogons' or 'virusprot'.",
+				MarkdownDescription: "Name of table, alias tables share the name of the alias, for example `bogons` or `virusprot`.",
+				Required:            true,
+			},
+			"entries": schema.ListAttribute{
+				Description: "Entries of table, in CIDR notation.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Time the table contents were last loaded from file (RFC 3339), only known for URL table aliases and the bogons tables.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *FirewallTableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallTableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallTableDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := pfsense.ValidateFirewallTableName(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+
+		return
+	}
+
+	table, err := d.client.GetFirewallTable(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get table", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, table)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallVirtualIPResource{}
+var _ resource.ResourceWithImportState = &FirewallVirtualIPResource{}
+
+func NewFirewallVirtualIPResource() resource.Resource {
+	return &FirewallVirtualIPResource{}
+}
+
+type FirewallVirtualIPResource struct {
+	client *pfsense.Client
+}
+
+type FirewallVirtualIPResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Mode              types.String `tfsdk:"mode"`
+	Interface         types.String `tfsdk:"interface"`
+	Address           types.String `tfsdk:"address"`
+	NoExpand          types.Bool   `tfsdk:"no_expand"`
+	VHID              types.Int64  `tfsdk:"vhid"`
+	AdvertisementBase types.Int64  `tfsdk:"advertisement_base"`
+	AdvertisementSkew types.Int64  `tfsdk:"advertisement_skew"`
+	Password          types.String `tfsdk:"password"`
+	Description       types.String `tfsdk:"description"`
+	Apply             types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallVirtualIPResourceModel) SetFromValue(ctx context.Context, vip *pfsense.FirewallVirtualIP) diag.Diagnostics {
+	r.ID = types.StringValue(vip.ID)
+	r.Mode = types.StringValue(vip.Mode)
+	r.Interface = types.StringValue(vip.Interface)
+	r.Address = types.StringValue(vip.Address.String())
+	r.NoExpand = types.BoolValue(vip.NoExpand)
+	r.VHID = optionalInt64Value(vip.VHID)
+	r.AdvertisementBase = optionalInt64Value(vip.AdvertisementBase)
+	r.AdvertisementSkew = optionalInt64Value(vip.AdvertisementSkew)
+
+	if vip.Description != "" {
+		r.Description = types.StringValue(vip.Description)
+	}
+
+	return nil
+}
+
+func (r FirewallVirtualIPResourceModel) Value(ctx context.Context) (*pfsense.FirewallVirtualIP, diag.Diagnostics) {
+	var vip pfsense.FirewallVirtualIP
+	var err error
+	var diags diag.Diagnostics
+
+	vip.ID = r.ID.ValueString()
+
+	err = vip.SetMode(r.Mode.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("mode"),
+			"Mode cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetInterface(r.Interface.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetAddress(r.Address.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("address"),
+			"Address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetNoExpand(r.NoExpand.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("no_expand"),
+			"No expand cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.VHID.IsNull() && !r.VHID.IsUnknown() {
+		err = vip.SetVHID(int(r.VHID.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("vhid"),
+				"VHID cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.AdvertisementBase.IsNull() && !r.AdvertisementBase.IsUnknown() {
+		err = vip.SetAdvertisementBase(int(r.AdvertisementBase.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("advertisement_base"),
+				"Advertisement base cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.AdvertisementSkew.IsNull() && !r.AdvertisementSkew.IsUnknown() {
+		err = vip.SetAdvertisementSkew(int(r.AdvertisementSkew.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("advertisement_skew"),
+				"Advertisement skew cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Password.IsNull() {
+		err = vip.SetPassword(r.Password.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("password"),
+				"Password cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Description.IsNull() {
+		err = vip.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return &vip, diags
+	}
+
+	err = vip.Validate()
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("mode"),
+			"Virtual IP settings do not match mode",
+			err.Error(),
+		)
+	}
+
+	return &vip, diags
+}
+
+func (r *FirewallVirtualIPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_virtual_ip", req.ProviderTypeName)
+}
+
+func (r *FirewallVirtualIPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall virtual IP, an additional address used by NAT, services, or high availability (CARP).",
+		MarkdownDescription: "Firewall [virtual IP](https://docs.netgate.com/pfsense/en/latest/firewall/virtual-ip-addresses.html), an additional address used by NAT, services, or high availability ([CARP](https://docs.netgate.com/pfsense/en/latest/highavailability/index.html)).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of virtual IP (unique ID generated on creation).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("Type of virtual IP, options: '%s'.", strings.Join(pfsense.VirtualIPModes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Type of virtual IP, options: `%s`.", strings.Join(pfsense.VirtualIPModes, "`, `")),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface on which the address is configured, for example 'wan' or 'lo0'.",
+				MarkdownDescription: "Interface on which the address is configured, for example `wan` or `lo0`.",
+				Required:            true,
+			},
+			"address": schema.StringAttribute{
+				Description:         "Address and prefix length, for example '192.168.1.10/24'. Proxy ARP and other virtual IPs may also specify a network.",
+				MarkdownDescription: "Address and prefix length, for example `192.168.1.10/24`. Proxy ARP and other virtual IPs may also specify a network.",
+				Required:            true,
+			},
+			"no_expand": schema.BoolAttribute{
+				Description:         "Disable expansion of a proxy ARP network into individual addresses, defaults to 'false'.",
+				MarkdownDescription: "Disable expansion of a proxy ARP network into individual addresses, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"vhid": schema.Int64Attribute{
+				Description: "CARP virtual host ID group, must match on all cluster members and be unique on the network segment.",
+				Optional:    true,
+			},
+			"advertisement_base": schema.Int64Attribute{
+				Description:         fmt.Sprintf("CARP advertisement frequency base in seconds, defaults to '%d' for CARP virtual IPs.", pfsense.DefaultVirtualIPAdvertisementBase),
+				MarkdownDescription: fmt.Sprintf("CARP advertisement frequency base in seconds, defaults to `%d` for CARP virtual IPs.", pfsense.DefaultVirtualIPAdvertisementBase),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"advertisement_skew": schema.Int64Attribute{
+				Description:         fmt.Sprintf("CARP advertisement frequency skew, the cluster member with the lowest skew is the primary, defaults to '%d' for CARP virtual IPs.", pfsense.DefaultVirtualIPAdvertisementSkew),
+				MarkdownDescription: fmt.Sprintf("CARP advertisement frequency skew, the cluster member with the lowest skew is the primary, defaults to `%d` for CARP virtual IPs.", pfsense.DefaultVirtualIPAdvertisementSkew),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "CARP virtual host ID group password, required for CARP virtual IPs. The password is not read back from pfSense, so changes made outside of Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallVirtualIPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallVirtualIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vipReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vip, err := r.client.CreateFirewallVirtualIP(ctx, *vipReq)
+	if addError(&resp.Diagnostics, "Error creating virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vip, err := r.client.GetFirewallVirtualIP(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallVirtualIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vipReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vip, err := r.client.UpdateFirewallVirtualIP(ctx, *vipReq)
+	if addError(&resp.Diagnostics, "Error updating virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallVirtualIPResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallVirtualIP(ctx, data.ID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting virtual IP", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -69,6 +72,65 @@ func addError(diag *diag.Diagnostics, summary string, err error) bool {
 	return false
 }
 
+// optionalInt64Value returns a null value for settings pfSense leaves blank.
+func optionalInt64Value(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*i))
+}
+
+// joinInts formats numeric choices for attribute descriptions.
+func joinInts(ints []int, sep string) string {
+	s := make([]string, 0, len(ints))
+	for _, i := range ints {
+		s = append(s, strconv.Itoa(i))
+	}
+
+	return strings.Join(s, sep)
+}
+
+// readPendingChanges sets the pending_changes attribute of an apply style resource from what pfSense reports.
+func readPendingChanges(ctx context.Context, resp *resource.ReadResponse, summary string, dirty func(context.Context) (bool, error)) {
+	pending, err := dirty(ctx)
+	if addError(&resp.Diagnostics, summary, err) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pending_changes"), pending)...)
+}
+
+// modifyPendingChangesPlan plans an update of an apply style resource when pending changes were read, made with apply
+// disabled or by other tools, so the update applies them.
+func modifyPendingChangesPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var pendingChanges types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pending_changes"), &pendingChanges)...)
+
+	if resp.Diagnostics.HasError() || !pendingChanges.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), false)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_updated"), types.StringUnknown())...)
+}
+
+// updatePendingChanges applies the changes for an update of an apply style resource, then records the planned values.
+func updatePendingChanges(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, summary string, apply func(context.Context) error) {
+	err := apply(ctx)
+	if addError(&resp.Diagnostics, summary, err) {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("last_updated"), time.Now().Format(time.RFC3339))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pending_changes"), false)...)
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &pfSenseProvider{
@@ -228,20 +290,48 @@ func (p *pfSenseProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 func (p *pfSenseProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDNSLookupDataSource,
+		NewDNSResolverCacheDataSource,
 		NewDNSResolverDomainOverridesDataSource,
 		NewDNSResolverHostOverridesDataSource,
+		NewDNSResolverInfraCacheDataSource,
+		NewDNSResolverStatsDataSource,
+		NewFirewallAliasReferencesDataSource,
 		NewFirewallAliasesDataSource,
+		NewFirewallIPAliasListDataSource,
+		NewFirewallLogDataSource,
+		NewFirewallRuleStatsDataSource,
+		NewFirewallSchedulesDataSource,
+		NewFirewallStatesDataSource,
+		NewFirewallTableDataSource,
 		NewSystemVersionDataSource,
 	}
 }
 
 func (p *pfSenseProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewDNSResolverAccessListResource,
+		NewDNSResolverAdvancedResource,
 		NewDNSResolverApplyResource,
 		NewDNSResolverConfigFileResource,
 		NewDNSResolverDomainOverrideResource,
+		NewDNSResolverGeneralResource,
 		NewDNSResolverHostOverrideResource,
+		NewFirewallAdvancedResource,
+		NewFirewallCARPMaintenanceModeResource,
 		NewFirewallFilterReloadResource,
 		NewFirewallIPAliasResource,
+		NewFirewallLimiterResource,
+		NewFirewallLimiterQueueResource,
+		NewFirewallNATNPTResource,
+		NewFirewallNATOneToOneResource,
+		NewFirewallNATOutboundMappingResource,
+		NewFirewallNATOutboundModeResource,
+		NewFirewallNATPortForwardResource,
+		NewFirewallScheduleResource,
+		NewFirewallShaperResource,
+		NewFirewallShaperQueueResource,
+		NewFirewallStateKillResource,
+		NewFirewallVirtualIPResource,
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -27,6 +28,15 @@ const (
 	DefaultMaxAttempts   = 3
 )
 
+const (
+	subsystemDNSResolver = "unbound"
+	subsystemFilter      = "filter"
+	subsystemAliases     = "aliases"
+	subsystemNATConfig   = "natconf"
+)
+
+var firewallFilterSubsystems = []string{subsystemFilter, subsystemAliases, subsystemNATConfig}
+
 type Options struct {
 	URL           *url.URL
 	Username      string
@@ -41,7 +51,20 @@ type mutexes struct {
 	DNSResolverApply          sync.Mutex
 	DNSResolverHostOverride   sync.Mutex
 	DNSResolverDomainOverride sync.Mutex
+	DNSResolverGeneral        sync.Mutex
+	DNSResolverAdvanced       sync.Mutex
+	DNSResolverAccessList     sync.Mutex
+	FirewallAdvanced          sync.Mutex
 	FirewallAlias             sync.Mutex
+	FirewallLimiter           sync.Mutex
+	FirewallNATNPT            sync.Mutex
+	FirewallNATOneToOne       sync.Mutex
+	FirewallNATOutbound       sync.Mutex
+	FirewallNATPortForward    sync.Mutex
+	FirewallSchedule          sync.Mutex
+	FirewallShaper            sync.Mutex
+	FirewallShaperApply       sync.Mutex
+	FirewallVirtualIP         sync.Mutex
 }
 
 type Client struct {
@@ -234,6 +257,21 @@ func (pf *Client) getConfigJSON(ctx context.Context, value string) (json.RawMess
 	return resp, nil
 }
 
+func (pf *Client) isSubsystemDirty(ctx context.Context, subsystem string) (bool, error) {
+	b, err := pf.runPHPCommand(ctx, fmt.Sprintf("print_r(json_encode(is_subsystem_dirty('%s')));", subsystem))
+	if err != nil {
+		return false, err
+	}
+
+	var dirty bool
+	err = json.Unmarshal(b, &dirty)
+	if err != nil {
+		return false, fmt.Errorf("%w subsystem dirty response, %w", ErrUnableToParse, err)
+	}
+
+	return dirty, nil
+}
+
 func removeEmptyStrings(s []string) []string {
 	var r []string
 	for _, str := range s {
@@ -243,3 +281,58 @@ func removeEmptyStrings(s []string) []string {
 	}
 	return r
 }
+
+func validateChoice(kind string, value string, choices []string) error {
+	for _, choice := range choices {
+		if value == choice {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, %s '%s' must be one of '%s'", ErrClientValidation, kind, value, strings.Join(choices, "', '"))
+}
+
+func validateIntChoice(kind string, value int, choices []int) error {
+	for _, choice := range choices {
+		if value == choice {
+			return nil
+		}
+	}
+
+	formatted := make([]string, 0, len(choices))
+	for _, choice := range choices {
+		formatted = append(formatted, strconv.Itoa(choice))
+	}
+
+	return fmt.Errorf("%w, %s '%d' must be one of '%s'", ErrClientValidation, kind, value, strings.Join(formatted, "', '"))
+}
+
+func validateIntRange(kind string, value int, minimum int, maximum int) error {
+	if value < minimum || value > maximum {
+		return fmt.Errorf("%w, %s '%d' must be between %d and %d", ErrClientValidation, kind, value, minimum, maximum)
+	}
+
+	return nil
+}
+
+// parseOptionalInt returns nil for values pfSense leaves blank.
+func parseOptionalInt(kind string, s string) (*int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s '%s'", ErrUnableToParse, kind, s)
+	}
+
+	return &i, nil
+}
+
+func formatOptionalInt(i *int) string {
+	if i == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*i)
+}
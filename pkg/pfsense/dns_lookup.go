@@ -0,0 +1,189 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	DNSLookupRecordTypeA     = "A"
+	DNSLookupRecordTypeAAAA  = "AAAA"
+	DNSLookupRecordTypeCNAME = "CNAME"
+	DNSLookupRecordTypePTR   = "PTR"
+)
+
+var DNSLookupRecordTypes = []string{
+	DNSLookupRecordTypeA,
+	DNSLookupRecordTypeAAAA,
+	DNSLookupRecordTypeCNAME,
+	DNSLookupRecordTypePTR,
+}
+
+var dnsLookupQueryTimeRegex = regexp.MustCompile(`^(\d+)\s*msec$`)
+
+type DNSLookup struct {
+	Name       string
+	RecordType string
+	Servers    []netip.Addr
+}
+
+func (lookup *DNSLookup) SetName(name string) error {
+	if name == "" || strings.ContainsAny(name, " \t\r\n") {
+		return fmt.Errorf("%w, lookup name '%s' must be a host name or IP address", ErrClientValidation, name)
+	}
+
+	lookup.Name = name
+
+	return nil
+}
+
+func (lookup *DNSLookup) SetRecordType(recordType string) error {
+	err := validateChoice("record type", recordType, DNSLookupRecordTypes)
+	if err != nil {
+		return err
+	}
+
+	lookup.RecordType = recordType
+
+	return nil
+}
+
+func (lookup *DNSLookup) SetServers(servers []string) error {
+	for _, server := range servers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil {
+			return fmt.Errorf("%w, %w", ErrClientValidation, err)
+		}
+
+		lookup.Servers = append(lookup.Servers, addr)
+	}
+
+	return nil
+}
+
+type DNSLookupAnswer struct {
+	Type string
+	Data string
+}
+
+// DNSLookupTiming is the query time of one name server, Error is set when the server did not respond.
+type DNSLookupTiming struct {
+	Server    string
+	QueryTime time.Duration
+	Error     string
+}
+
+func (timing DNSLookupTiming) Responded() bool {
+	return timing.Error == ""
+}
+
+type DNSLookupResult struct {
+	Answers []DNSLookupAnswer
+	Timings []DNSLookupTiming
+}
+
+func scrapeDNSLookupTable(doc *goquery.Document, title string) [][]string {
+	var rows [][]string
+
+	panel := doc.FindMatcher(goquery.Single(fmt.Sprintf("div.panel:has(h2.panel-title:contains('%s'))", title)))
+	panel.Find("table tbody tr").Each(func(_ int, tr *goquery.Selection) {
+		var row []string
+		tr.Find("td").Each(func(_ int, td *goquery.Selection) {
+			row = append(row, strings.TrimSpace(td.Text()))
+		})
+		rows = append(rows, row)
+	})
+
+	return rows
+}
+
+// LookupDNS resolves the name with diag_dns.php, answers and timings are filtered by the record type and servers when set.
+func (pf *Client) LookupDNS(ctx context.Context, lookupReq DNSLookup) (*DNSLookupResult, error) {
+	u := url.URL{Path: "diag_dns.php"}
+	v := url.Values{
+		"host":   {lookupReq.Name},
+		"lookup": {"Lookup"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS lookup, %w", ErrGetOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS lookup, %w", ErrGetOperationFailed, err)
+	}
+
+	result, err := parseDNSLookupPage(doc, lookupReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS lookup, %w", ErrGetOperationFailed, err)
+	}
+
+	return result, nil
+}
+
+func parseDNSLookupPage(doc *goquery.Document, lookupReq DNSLookup) (*DNSLookupResult, error) {
+	var result DNSLookupResult
+
+	for _, row := range scrapeDNSLookupTable(doc, "Results") {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("%w result row '%s'", ErrUnableToParse, strings.Join(row, ", "))
+		}
+
+		if lookupReq.RecordType != "" && !strings.EqualFold(row[1], lookupReq.RecordType) {
+			continue
+		}
+
+		result.Answers = append(result.Answers, DNSLookupAnswer{Type: row[1], Data: row[0]})
+	}
+
+	timings := map[netip.Addr]DNSLookupTiming{}
+	for _, row := range scrapeDNSLookupTable(doc, "Timings") {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("%w timing row '%s'", ErrUnableToParse, strings.Join(row, ", "))
+		}
+
+		timing := DNSLookupTiming{Server: row[0]}
+
+		if match := dnsLookupQueryTimeRegex.FindStringSubmatch(row[1]); match != nil {
+			ms, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("%w query time '%s'", ErrUnableToParse, row[1])
+			}
+
+			timing.QueryTime = time.Duration(ms) * time.Millisecond
+		} else {
+			timing.Error = row[1]
+		}
+
+		if len(lookupReq.Servers) == 0 {
+			result.Timings = append(result.Timings, timing)
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(timing.Server); err == nil {
+			timings[addr.WithZone("")] = timing
+		}
+	}
+
+	for _, server := range lookupReq.Servers {
+		timing, ok := timings[server.WithZone("")]
+		if !ok {
+			return nil, fmt.Errorf("%w, server '%s' is not a system name server", ErrClientValidation, server)
+		}
+
+		result.Timings = append(result.Timings, timing)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,116 @@
+package pfsense
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// result and timing panels of diag_dns.php on pfSense, trimmed to the page content.
+const dnsLookupPageFixture = `<div class="panel panel-default">
+	<div class="panel-heading"><h2 class="panel-title">Results</h2></div>
+	<div class="panel-body">
+		<table class="table">
+		<thead>
+			<tr>
+				<th>Result</th>
+				<th>Record type</th>
+			</tr>
+		</thead>
+		<tbody>
+		<tr>
+			<td>10.10.10.10</td><td>A</td>
+		</tr>
+		<tr>
+			<td>2001:db8::10</td><td>AAAA</td>
+		</tr>
+		</tbody>
+		</table>
+	</div>
+</div>
+<div class="panel panel-default">
+	<div class="panel-heading"><h2 class="panel-title">Timings</h2></div>
+	<div class="panel-body">
+		<table class="table">
+		<thead>
+			<tr>
+				<th>Name server</th>
+				<th>Query time</th>
+			</tr>
+		</thead>
+		<tbody>
+		<tr>
+			<td>127.0.0.1</td><td> 12 msec</td>
+		</tr>
+		<tr>
+			<td>192.0.2.53</td><td>No response</td>
+		</tr>
+		</tbody>
+		</table>
+	</div>
+</div>`
+
+func TestParseDNSLookupPage(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(dnsLookupPageFixture))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		lookup  DNSLookup
+		answers []DNSLookupAnswer
+		timings []DNSLookupTiming
+	}{
+		{
+			name:    "all",
+			lookup:  DNSLookup{Name: "app.example.com"},
+			answers: []DNSLookupAnswer{{Type: "A", Data: "10.10.10.10"}, {Type: "AAAA", Data: "2001:db8::10"}},
+			timings: []DNSLookupTiming{{Server: "127.0.0.1", QueryTime: 12 * time.Millisecond}, {Server: "192.0.2.53", Error: "No response"}},
+		},
+		{
+			name:    "filtered",
+			lookup:  DNSLookup{Name: "app.example.com", RecordType: DNSLookupRecordTypeAAAA, Servers: []netip.Addr{netip.MustParseAddr("127.0.0.1")}},
+			answers: []DNSLookupAnswer{{Type: "AAAA", Data: "2001:db8::10"}},
+			timings: []DNSLookupTiming{{Server: "127.0.0.1", QueryTime: 12 * time.Millisecond}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseDNSLookupPage(doc, tt.lookup)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(result.Answers) != len(tt.answers) {
+				t.Fatalf("expected %d answers, got %d", len(tt.answers), len(result.Answers))
+			}
+
+			for i := range tt.answers {
+				if result.Answers[i] != tt.answers[i] {
+					t.Errorf("answer %d: expected %+v, got %+v", i, tt.answers[i], result.Answers[i])
+				}
+			}
+
+			if len(result.Timings) != len(tt.timings) {
+				t.Fatalf("expected %d timings, got %d", len(tt.timings), len(result.Timings))
+			}
+
+			for i := range tt.timings {
+				if result.Timings[i] != tt.timings[i] {
+					t.Errorf("timing %d: expected %+v, got %+v", i, tt.timings[i], result.Timings[i])
+				}
+			}
+		})
+	}
+
+	_, err = parseDNSLookupPage(doc, DNSLookup{Name: "app.example.com", Servers: []netip.Addr{netip.MustParseAddr("198.51.100.1")}})
+	if !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected %v for a server which is not a system name server, got %v", ErrClientValidation, err)
+	}
+}
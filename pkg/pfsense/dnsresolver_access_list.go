@@ -0,0 +1,332 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+)
+
+const (
+	AccessListActionAllow          = "allow"
+	AccessListActionDeny           = "deny"
+	AccessListActionRefuse         = "refuse"
+	AccessListActionAllowSnoop     = "allow snoop"
+	AccessListActionDenyNonLocal   = "deny nonlocal"
+	AccessListActionRefuseNonLocal = "refuse nonlocal"
+)
+
+var AccessListActions = []string{
+	AccessListActionAllow,
+	AccessListActionDeny,
+	AccessListActionRefuse,
+	AccessListActionAllowSnoop,
+	AccessListActionDenyNonLocal,
+	AccessListActionRefuseNonLocal,
+}
+
+type accessListNetworkResponse struct {
+	Network     string `json:"acl_network"`
+	Mask        string `json:"mask"`
+	Description string `json:"description"`
+}
+
+type accessListResponse struct {
+	ID          string                      `json:"aclid"`
+	Name        string                      `json:"aclname"`
+	Action      string                      `json:"aclaction"`
+	Description string                      `json:"description"`
+	Networks    []accessListNetworkResponse `json:"row"`
+	ControlID   int                         `json:"controlID"`
+}
+
+type AccessList struct {
+	Name        string
+	Action      string
+	Description string
+	Networks    []AccessListNetwork
+	aclID       string
+	controlID   int
+}
+
+type AccessListNetwork struct {
+	Network     netip.Prefix
+	Description string
+}
+
+func (al *AccessList) SetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w, access list name is required", ErrClientValidation)
+	}
+
+	al.Name = name
+
+	return nil
+}
+
+func (al *AccessList) SetAction(action string) error {
+	err := validateChoice("access list action", action, AccessListActions)
+	if err != nil {
+		return err
+	}
+
+	al.Action = action
+
+	return nil
+}
+
+func (al *AccessList) SetDescription(description string) error {
+	al.Description = description
+
+	return nil
+}
+
+func (network *AccessListNetwork) SetNetwork(prefix string) error {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	if p.Masked() != p {
+		return fmt.Errorf("%w, network '%s' has host bits set, use '%s'", ErrClientValidation, prefix, p.Masked())
+	}
+
+	network.Network = p
+
+	return nil
+}
+
+func (network *AccessListNetwork) SetDescription(description string) error {
+	network.Description = description
+
+	return nil
+}
+
+type AccessLists []AccessList
+
+func (als AccessLists) GetByName(name string) (*AccessList, error) {
+	for _, al := range als {
+		if al.Name == name {
+			return &al, nil
+		}
+	}
+	return nil, fmt.Errorf("access list %w with name '%s'", ErrNotFound, name)
+}
+
+func (als AccessLists) GetControlIDByName(name string) (*int, error) {
+	for _, al := range als {
+		if al.Name == name {
+			return &al.controlID, nil
+		}
+	}
+	return nil, fmt.Errorf("access list %w with name '%s'", ErrNotFound, name)
+}
+
+func (pf *Client) getDNSResolverAccessLists(ctx context.Context) (*AccessLists, error) {
+	command := "$output = array();" +
+		"foreach ($config['unbound']['acls'] ?? array() as $k => $v) {" +
+		"$v['aclid'] = strval($v['aclid'] ?? ''); $v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var alResp []accessListResponse
+	err = json.Unmarshal(b, &alResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var accessLists AccessLists
+	for _, resp := range alResp {
+		var accessList AccessList
+		var err error
+
+		err = accessList.SetName(resp.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w access list response, %w", ErrUnableToParse, err)
+		}
+
+		err = accessList.SetAction(resp.Action)
+		if err != nil {
+			return nil, fmt.Errorf("%w access list response, %w", ErrUnableToParse, err)
+		}
+
+		err = accessList.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w access list response, %w", ErrUnableToParse, err)
+		}
+
+		for _, networkResp := range resp.Networks {
+			var network AccessListNetwork
+
+			err = network.SetNetwork(fmt.Sprintf("%s/%s", networkResp.Network, networkResp.Mask))
+			if err != nil {
+				return nil, fmt.Errorf("%w access list response, %w", ErrUnableToParse, err)
+			}
+
+			err = network.SetDescription(networkResp.Description)
+			if err != nil {
+				return nil, fmt.Errorf("%w access list response, %w", ErrUnableToParse, err)
+			}
+
+			accessList.Networks = append(accessList.Networks, network)
+		}
+
+		accessList.aclID = resp.ID
+		accessList.controlID = resp.ControlID
+
+		accessLists = append(accessLists, accessList)
+	}
+
+	return &accessLists, nil
+}
+
+func (pf *Client) GetDNSResolverAccessLists(ctx context.Context) (*AccessLists, error) {
+	pf.mutexes.DNSResolverAccessList.Lock()
+	defer pf.mutexes.DNSResolverAccessList.Unlock()
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w access lists, %w", ErrGetOperationFailed, err)
+	}
+
+	return accessLists, nil
+}
+
+func (pf *Client) GetDNSResolverAccessList(ctx context.Context, name string) (*AccessList, error) {
+	pf.mutexes.DNSResolverAccessList.Lock()
+	defer pf.mutexes.DNSResolverAccessList.Unlock()
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return accessLists.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateDNSResolverAccessList(ctx context.Context, accessListReq AccessList, existing *AccessList) (*AccessList, error) {
+	u := url.URL{Path: "services_unbound_acls.php"}
+	v := url.Values{
+		"aclname":     {accessListReq.Name},
+		"aclaction":   {accessListReq.Action},
+		"description": {accessListReq.Description},
+		"save":        {"Save"},
+	}
+
+	for i, network := range accessListReq.Networks {
+		v.Set(fmt.Sprintf("acl_network%d", i), network.Network.Addr().String())
+		v.Set(fmt.Sprintf("mask%d", i), strconv.Itoa(network.Network.Bits()))
+		v.Set(fmt.Sprintf("description%d", i), network.Description)
+	}
+
+	if existing != nil {
+		v.Set("aclid", existing.aclID)
+
+		q := u.Query()
+		q.Set("act", "edit")
+		q.Set("id", strconv.Itoa(existing.controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList, err := accessLists.GetByName(accessListReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessList, nil
+}
+
+func (pf *Client) CreateDNSResolverAccessList(ctx context.Context, accessListReq AccessList) (*AccessList, error) {
+	pf.mutexes.DNSResolverAccessList.Lock()
+	defer pf.mutexes.DNSResolverAccessList.Unlock()
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list, %w", ErrCreateOperationFailed, err)
+	}
+
+	// access lists are identified by name, pfSense itself does not require names to be unique.
+	if _, err := accessLists.GetByName(accessListReq.Name); err == nil {
+		return nil, fmt.Errorf("%w access list, %w, access list with name '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, accessListReq.Name)
+	}
+
+	accessList, err := pf.createOrUpdateDNSResolverAccessList(ctx, accessListReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list, %w", ErrCreateOperationFailed, err)
+	}
+
+	return accessList, nil
+}
+
+func (pf *Client) UpdateDNSResolverAccessList(ctx context.Context, accessListReq AccessList) (*AccessList, error) {
+	pf.mutexes.DNSResolverAccessList.Lock()
+	defer pf.mutexes.DNSResolverAccessList.Unlock()
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := accessLists.GetByName(accessListReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list, %w", ErrUpdateOperationFailed, err)
+	}
+
+	accessList, err := pf.createOrUpdateDNSResolverAccessList(ctx, accessListReq, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%w access list, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return accessList, nil
+}
+
+func (pf *Client) DeleteDNSResolverAccessList(ctx context.Context, name string) error {
+	pf.mutexes.DNSResolverAccessList.Lock()
+	defer pf.mutexes.DNSResolverAccessList.Unlock()
+
+	accessLists, err := pf.getDNSResolverAccessLists(ctx)
+	if err != nil {
+		return fmt.Errorf("%w access list, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := accessLists.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w access list, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "services_unbound_acls.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w access list, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
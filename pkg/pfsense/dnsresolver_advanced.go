@@ -0,0 +1,331 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	DefaultDNSResolverMessageCacheSize         = 4
+	DefaultDNSResolverInfrastructureCacheHosts = 10000
+	DefaultDNSResolverMinimumTTL               = 0
+	DefaultDNSResolverMaximumTTL               = 86400
+	DefaultDNSResolverLogVerbosity             = 1
+	MinDNSResolverLogVerbosity                 = 0
+	MaxDNSResolverLogVerbosity                 = 5
+	MaxDNSResolverTTL                          = math.MaxInt32
+	dnsResolverAdvancedEDNSBufferSizeAuto      = "auto"
+)
+
+var (
+	DNSResolverMessageCacheSizes        = []int{4, 10, 20, 50, 100, 250, 512}
+	DNSResolverInfrastructureCacheHosts = []int{1000, 5000, 10000, 20000, 50000, 100000, 200000}
+	DNSResolverEDNSBufferSizes          = []int{512, 1220, 1232, 1432, 1480, 4096}
+)
+
+type dnsResolverAdvancedResponse struct {
+	MessageCacheSize    string `json:"msgcachesize"`
+	InfrastructureHosts string `json:"infra_cache_numhosts"`
+	Prefetch            bool   `json:"prefetch"`
+	PrefetchDNSKey      bool   `json:"prefetchkey"`
+	ServeExpired        bool   `json:"dnsrecordcache"`
+	MinimumTTL          string `json:"cache_min_ttl"`
+	MaximumTTL          string `json:"cache_max_ttl"`
+	HideIdentity        bool   `json:"hideidentity"`
+	HideVersion         bool   `json:"hideversion"`
+	AggressiveNSEC      bool   `json:"aggressivensec"`
+	LogVerbosity        string `json:"log_verbosity"`
+	EDNSBufferSize      string `json:"edns_buffer_size"`
+}
+
+// DNSResolverAdvanced is the subset of services_unbound_advanced.php settings managed by the provider.
+type DNSResolverAdvanced struct {
+	MessageCacheSize    int
+	InfrastructureHosts int
+	Prefetch            bool
+	PrefetchDNSKey      bool
+	ServeExpired        bool
+	MinimumTTL          int
+	MaximumTTL          int
+	HideIdentity        bool
+	HideVersion         bool
+	AggressiveNSEC      bool
+	LogVerbosity        int
+	EDNSBufferSize      *int
+}
+
+func (adv DNSResolverAdvanced) Validate() error {
+	if adv.MinimumTTL > adv.MaximumTTL {
+		return fmt.Errorf("%w, minimum TTL (%d) cannot be greater than maximum TTL (%d)", ErrClientValidation, adv.MinimumTTL, adv.MaximumTTL)
+	}
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetMessageCacheSize(size int) error {
+	err := validateIntChoice("message cache size", size, DNSResolverMessageCacheSizes)
+	if err != nil {
+		return err
+	}
+
+	adv.MessageCacheSize = size
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetInfrastructureHosts(hosts int) error {
+	err := validateIntChoice("infrastructure cache hosts", hosts, DNSResolverInfrastructureCacheHosts)
+	if err != nil {
+		return err
+	}
+
+	adv.InfrastructureHosts = hosts
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetPrefetch(enabled bool) error {
+	adv.Prefetch = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetPrefetchDNSKey(enabled bool) error {
+	adv.PrefetchDNSKey = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetServeExpired(enabled bool) error {
+	adv.ServeExpired = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetMinimumTTL(ttl int) error {
+	err := validateIntRange("minimum TTL", ttl, 0, MaxDNSResolverTTL)
+	if err != nil {
+		return err
+	}
+
+	adv.MinimumTTL = ttl
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetMaximumTTL(ttl int) error {
+	err := validateIntRange("maximum TTL", ttl, 0, MaxDNSResolverTTL)
+	if err != nil {
+		return err
+	}
+
+	adv.MaximumTTL = ttl
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetHideIdentity(enabled bool) error {
+	adv.HideIdentity = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetHideVersion(enabled bool) error {
+	adv.HideVersion = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetAggressiveNSEC(enabled bool) error {
+	adv.AggressiveNSEC = enabled
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetLogVerbosity(verbosity int) error {
+	err := validateIntRange("log verbosity", verbosity, MinDNSResolverLogVerbosity, MaxDNSResolverLogVerbosity)
+	if err != nil {
+		return err
+	}
+
+	adv.LogVerbosity = verbosity
+
+	return nil
+}
+
+func (adv *DNSResolverAdvanced) SetEDNSBufferSize(size int) error {
+	err := validateIntChoice("EDNS buffer size", size, DNSResolverEDNSBufferSizes)
+	if err != nil {
+		return err
+	}
+
+	adv.EDNSBufferSize = &size
+
+	return nil
+}
+
+func (adv DNSResolverAdvanced) formatEDNSBufferSize() string {
+	if adv.EDNSBufferSize == nil {
+		return dnsResolverAdvancedEDNSBufferSizeAuto
+	}
+
+	return strconv.Itoa(*adv.EDNSBufferSize)
+}
+
+func parseDNSResolverAdvancedResponse(resp dnsResolverAdvancedResponse) (*DNSResolverAdvanced, error) {
+	var adv DNSResolverAdvanced
+
+	for _, field := range []struct {
+		name         string
+		value        string
+		defaultValue int
+		set          func(int) error
+	}{
+		{"message cache size", resp.MessageCacheSize, DefaultDNSResolverMessageCacheSize, adv.SetMessageCacheSize},
+		{"infrastructure cache hosts", resp.InfrastructureHosts, DefaultDNSResolverInfrastructureCacheHosts, adv.SetInfrastructureHosts},
+		{"minimum TTL", resp.MinimumTTL, DefaultDNSResolverMinimumTTL, adv.SetMinimumTTL},
+		{"maximum TTL", resp.MaximumTTL, DefaultDNSResolverMaximumTTL, adv.SetMaximumTTL},
+		{"log verbosity", resp.LogVerbosity, DefaultDNSResolverLogVerbosity, adv.SetLogVerbosity},
+	} {
+		i, err := parseOptionalInt(field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == nil {
+			i = &field.defaultValue
+		}
+
+		err = field.set(*i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.EDNSBufferSize != "" && resp.EDNSBufferSize != dnsResolverAdvancedEDNSBufferSizeAuto {
+		i, err := parseOptionalInt("EDNS buffer size", resp.EDNSBufferSize)
+		if err != nil {
+			return nil, err
+		}
+
+		err = adv.SetEDNSBufferSize(*i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	adv.Prefetch = resp.Prefetch
+	adv.PrefetchDNSKey = resp.PrefetchDNSKey
+	adv.ServeExpired = resp.ServeExpired
+	adv.HideIdentity = resp.HideIdentity
+	adv.HideVersion = resp.HideVersion
+	adv.AggressiveNSEC = resp.AggressiveNSEC
+
+	return &adv, nil
+}
+
+func (pf *Client) getDNSResolverAdvanced(ctx context.Context) (*DNSResolverAdvanced, error) {
+	command := "$u = $config['unbound'] ?? array();" +
+		"print_r(json_encode(array(" +
+		"'msgcachesize' => $u['msgcachesize'] ?? ''," +
+		"'infra_cache_numhosts' => $u['infra_cache_numhosts'] ?? ''," +
+		"'prefetch' => isset($u['prefetch'])," +
+		"'prefetchkey' => isset($u['prefetchkey'])," +
+		"'dnsrecordcache' => isset($u['dnsrecordcache'])," +
+		"'cache_min_ttl' => $u['cache_min_ttl'] ?? ''," +
+		"'cache_max_ttl' => $u['cache_max_ttl'] ?? ''," +
+		"'hideidentity' => isset($u['hideidentity'])," +
+		"'hideversion' => isset($u['hideversion'])," +
+		"'aggressivensec' => isset($u['aggressivensec'])," +
+		"'log_verbosity' => $u['log_verbosity'] ?? ''," +
+		"'edns_buffer_size' => $u['edns_buffer_size'] ?? '')));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var advResp dnsResolverAdvancedResponse
+	err = json.Unmarshal(b, &advResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	adv, err := parseDNSResolverAdvancedResponse(advResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced response, %w", ErrUnableToParse, err)
+	}
+
+	return adv, nil
+}
+
+func (pf *Client) GetDNSResolverAdvanced(ctx context.Context) (*DNSResolverAdvanced, error) {
+	pf.mutexes.DNSResolverAdvanced.Lock()
+	defer pf.mutexes.DNSResolverAdvanced.Unlock()
+
+	adv, err := pf.getDNSResolverAdvanced(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return adv, nil
+}
+
+// UpdateDNSResolverAdvanced saves the settings, the page resets fields which are not submitted so all other fields are posted as currently shown.
+func (pf *Client) UpdateDNSResolverAdvanced(ctx context.Context, advReq DNSResolverAdvanced) (*DNSResolverAdvanced, error) {
+	pf.mutexes.DNSResolverAdvanced.Lock()
+	defer pf.mutexes.DNSResolverAdvanced.Unlock()
+
+	err := advReq.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	u := url.URL{Path: "services_unbound_advanced.php"}
+
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v, err := scrapeHTMLFormValues(doc, "form.form-horizontal")
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v.Set("msgcachesize", strconv.Itoa(advReq.MessageCacheSize))
+	v.Set("infra_cache_numhosts", strconv.Itoa(advReq.InfrastructureHosts))
+	setDNSResolverFlag(v, "prefetch", advReq.Prefetch)
+	setDNSResolverFlag(v, "prefetchkey", advReq.PrefetchDNSKey)
+	setDNSResolverFlag(v, "dnsrecordcache", advReq.ServeExpired)
+	v.Set("cache_min_ttl", strconv.Itoa(advReq.MinimumTTL))
+	v.Set("cache_max_ttl", strconv.Itoa(advReq.MaximumTTL))
+	setDNSResolverFlag(v, "hideidentity", advReq.HideIdentity)
+	setDNSResolverFlag(v, "hideversion", advReq.HideVersion)
+	setDNSResolverFlag(v, "aggressivensec", advReq.AggressiveNSEC)
+	v.Set("log_verbosity", strconv.Itoa(advReq.LogVerbosity))
+	v.Set("edns_buffer_size", advReq.formatEDNSBufferSize())
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	adv, err := pf.getDNSResolverAdvanced(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return adv, nil
+}
@@ -2,33 +2,203 @@ package pfsense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dnsResolverControlPath        = "/usr/local/sbin/unbound-control"
+	dnsResolverConfigPath         = "/var/unbound/unbound.conf"
+	dnsResolverLogPath            = "/var/log/resolver.log"
+	dnsResolverLogLines           = 20
+	dnsResolverHealthPollInterval = time.Second
+	dnsResolverHealthTimeout      = 30 * time.Second
 )
 
 var (
 	ErrApplyDNSResolverChange = errors.New("failed to apply DNS resolver changes")
 )
 
+type dnsResolverStatusResponse struct {
+	Enabled    bool     `json:"enabled"`
+	Output     []string `json:"output"`
+	ReturnCode int      `json:"return_code"`
+}
+
+// DNSResolverApplyError is returned when changes were submitted but the resolver did not come back healthy,
+// LogLines holds the tail of the resolver log to help find the cause.
+type DNSResolverApplyError struct {
+	Reason   string
+	LogLines []string
+}
+
+func (e *DNSResolverApplyError) Error() string {
+	if len(e.LogLines) == 0 {
+		return fmt.Sprintf("%s, %s", ErrApplyDNSResolverChange, e.Reason)
+	}
+	return fmt.Sprintf("%s, %s, last log lines:\n%s", ErrApplyDNSResolverChange, e.Reason, strings.Join(e.LogLines, "\n"))
+}
+
+func (e *DNSResolverApplyError) Unwrap() error {
+	return ErrApplyDNSResolverChange
+}
+
+func (pf *Client) getDNSResolverStatus(ctx context.Context) (*dnsResolverStatusResponse, error) {
+	command := "$output = array(); $return_code = 0;" +
+		"$enabled = isset($config['unbound']['enable']);" +
+		fmt.Sprintf("if ($enabled) { exec('%s -c %s status 2>&1', $output, $return_code); };", dnsResolverControlPath, dnsResolverConfigPath) +
+		"print_r(json_encode(array('enabled' => $enabled, 'output' => $output, 'return_code' => $return_code)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusResp dnsResolverStatusResponse
+	err = json.Unmarshal(b, &statusResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	return &statusResp, nil
+}
+
+// unbound-control reports the uptime as 'uptime: N seconds'.
+func (resp dnsResolverStatusResponse) uptime() (*time.Duration, bool) {
+	re := regexp.MustCompile(`^uptime:\s+(\d+)\s+seconds`)
+	for _, line := range resp.Output {
+		matches := re.FindStringSubmatch(strings.TrimSpace(line))
+		if len(matches) != 2 {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, false
+		}
+
+		d := time.Duration(seconds) * time.Second
+		return &d, true
+	}
+
+	return nil, false
+}
+
+func (pf *Client) getDNSResolverLogLines(ctx context.Context) []string {
+	command := fmt.Sprintf("exec('tail -n %d %s 2>&1', $output);", dnsResolverLogLines, dnsResolverLogPath) +
+		"print_r(json_encode($output ?? array()));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil
+	}
+
+	var lines []string
+	if json.Unmarshal(b, &lines) != nil {
+		return nil
+	}
+
+	return lines
+}
+
+// the apply returns before unbound has restarted with the changes.
+func (pf *Client) waitForDNSResolver(ctx context.Context, appliedAt time.Time) error {
+	deadline := time.Now().Add(dnsResolverHealthTimeout)
+	reason := "resolver did not report status"
+
+	for {
+		status, err := pf.getDNSResolverStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !status.Enabled {
+			return nil
+		}
+
+		// uptime has one second resolution, allow for rounding when comparing to the apply time
+		uptime, ok := status.uptime()
+		switch {
+		case status.ReturnCode != 0:
+			reason = fmt.Sprintf("resolver is not running, '%s'", strings.Join(removeEmptyStrings(status.Output), ", "))
+		case !ok:
+			reason = "unable to determine resolver uptime"
+		case *uptime > time.Since(appliedAt)+time.Second:
+			reason = "resolver was not restarted with the new configuration"
+		default:
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return &DNSResolverApplyError{Reason: reason, LogLines: pf.getDNSResolverLogLines(ctx)}
+		}
+
+		timer := time.NewTimer(dnsResolverHealthPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ApplyDNSResolverChanges applies pending changes and verifies the resolver is running with them.
 func (pf *Client) ApplyDNSResolverChanges(ctx context.Context) error {
 	pf.mutexes.DNSResolverApply.Lock()
 	defer pf.mutexes.DNSResolverApply.Unlock()
 
+	appliedAt := time.Now()
+
 	u := url.URL{Path: "services_unbound.php"}
 	v := url.Values{
 		"apply": {"Apply Changes"},
 	}
 
-	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyDNSResolverChange, err)
+	}
+
+	err = scrapeHTMLApplyErrors(doc)
+	if err != nil {
+		return &DNSResolverApplyError{Reason: err.Error(), LogLines: pf.getDNSResolverLogLines(ctx)}
+	}
+
+	dirty, err := pf.isSubsystemDirty(ctx, subsystemDNSResolver)
 	if err != nil {
 		return fmt.Errorf("%w, %w", ErrApplyDNSResolverChange, err)
 	}
 
-	defer resp.Body.Close()
-	_, _ = io.Copy(io.Discard, resp.Body)
+	if dirty {
+		return &DNSResolverApplyError{Reason: "changes are still pending after apply", LogLines: pf.getDNSResolverLogLines(ctx)}
+	}
+
+	err = pf.waitForDNSResolver(ctx, appliedAt)
+	if err != nil {
+		var applyErr *DNSResolverApplyError
+		if errors.As(err, &applyErr) {
+			return err
+		}
+		return fmt.Errorf("%w, %w", ErrApplyDNSResolverChange, err)
+	}
 
 	return nil
 }
+
+// IsDNSResolverDirty reports whether DNS resolver changes are pending an apply.
+func (pf *Client) IsDNSResolverDirty(ctx context.Context) (bool, error) {
+	dirty, err := pf.isSubsystemDirty(ctx, subsystemDNSResolver)
+	if err != nil {
+		return false, fmt.Errorf("%w DNS resolver pending changes, %w", ErrGetOperationFailed, err)
+	}
+
+	return dirty, nil
+}
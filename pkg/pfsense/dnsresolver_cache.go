@@ -0,0 +1,220 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var dnsRecordRegex = regexp.MustCompile(`^(\S+)\s+(\d+)\s+(\S+)\s+(\S+)\s+(.*)$`)
+
+// DNSResolverInfraCacheEntry is the state kept by the resolver about an upstream server for a zone, timings are rounded to milliseconds.
+type DNSResolverInfraCacheEntry struct {
+	Address       netip.Addr
+	Zone          string
+	Expired       bool
+	TTL           time.Duration
+	Ping          time.Duration
+	Variance      time.Duration
+	RTT           time.Duration
+	RTO           time.Duration
+	TimeoutsA     int
+	TimeoutsAAAA  int
+	TimeoutsOther int
+	EDNSKnown     bool
+	EDNSVersion   int
+	Lame          bool
+}
+
+type DNSResolverInfraCache []DNSResolverInfraCacheEntry
+
+func (cache DNSResolverInfraCache) GetByZone(zone string) DNSResolverInfraCache {
+	var filtered DNSResolverInfraCache
+	for _, entry := range cache {
+		if strings.EqualFold(entry.Zone, zone) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// ParseDNSResolverInfraCache parses the output of 'unbound-control dump_infra', each line is an address and zone followed by
+// 'name value' pairs, with 'expired' in place of the pairs for entries past their TTL and a 'lame' section for lame servers.
+func ParseDNSResolverInfraCache(lines []string) (DNSResolverInfraCache, error) {
+	var cache DNSResolverInfraCache
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		address, _, _ := strings.Cut(fields[0], "@")
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w infra cache address '%s'", ErrUnableToParse, fields[0])
+		}
+
+		entry := DNSResolverInfraCacheEntry{Address: addr, Zone: fields[1]}
+		values := map[string]int{}
+
+		for i := 2; i < len(fields); i++ {
+			switch fields[i] {
+			case "expired":
+				entry.Expired = true
+				continue
+			case "lame":
+				entry.Lame = true
+				continue
+			}
+
+			if i+1 >= len(fields) {
+				return nil, fmt.Errorf("%w infra cache line '%s', missing value for '%s'", ErrUnableToParse, line, fields[i])
+			}
+
+			value, err := strconv.Atoi(fields[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("%w infra cache line '%s', value of '%s'", ErrUnableToParse, line, fields[i])
+			}
+
+			values[fields[i]] = value
+			i++
+		}
+
+		ms := func(name string) time.Duration {
+			return time.Duration(values[name]) * time.Millisecond
+		}
+
+		entry.TTL = time.Duration(values["ttl"]) * time.Second
+		entry.Ping, entry.Variance, entry.RTT, entry.RTO = ms("ping"), ms("var"), ms("rtt"), ms("rto")
+		entry.TimeoutsA, entry.TimeoutsAAAA, entry.TimeoutsOther = values["tA"], values["tAAAA"], values["tother"]
+		entry.EDNSKnown = values["ednsknown"] != 0
+		entry.EDNSVersion = values["edns"]
+
+		// the lame section lists which kinds of queries the server is lame for, all zero means it is no longer lame.
+		if entry.Lame {
+			entry.Lame = values["dnssec"] != 0 || values["rec"] != 0 || values["A"] != 0 || values["other"] != 0
+		}
+
+		cache = append(cache, entry)
+	}
+
+	return cache, nil
+}
+
+func (pf *Client) GetDNSResolverInfraCache(ctx context.Context) (*DNSResolverInfraCache, error) {
+	lines, err := pf.runDNSResolverControl(ctx, "dump_infra")
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver infra cache, %w", ErrGetOperationFailed, err)
+	}
+
+	cache, err := ParseDNSResolverInfraCache(lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver infra cache, %w", ErrGetOperationFailed, err)
+	}
+
+	return &cache, nil
+}
+
+// DNSResolverCacheRecord is a record held in the resolver's RRset cache, TTL is the time remaining.
+type DNSResolverCacheRecord struct {
+	Name  string
+	TTL   time.Duration
+	Class string
+	Type  string
+	Data  string
+}
+
+type DNSResolverCacheRecords []DNSResolverCacheRecord
+
+func (records DNSResolverCacheRecords) GetByType(recordType string) DNSResolverCacheRecords {
+	var filtered DNSResolverCacheRecords
+	for _, record := range records {
+		if strings.EqualFold(record.Type, recordType) {
+			filtered = append(filtered, record)
+		}
+	}
+
+	return filtered
+}
+
+// ParseDNSResolverCacheRecords parses zone file style lines from the RRset section of 'unbound-control dump_cache'.
+func ParseDNSResolverCacheRecords(lines []string) (DNSResolverCacheRecords, error) {
+	var records DNSResolverCacheRecords
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		match := dnsRecordRegex.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("%w cache record '%s'", ErrUnableToParse, line)
+		}
+
+		ttl, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, fmt.Errorf("%w cache record TTL '%s'", ErrUnableToParse, match[2])
+		}
+
+		records = append(records, DNSResolverCacheRecord{
+			Name:  match[1],
+			TTL:   time.Duration(ttl) * time.Second,
+			Class: match[3],
+			Type:  match[4],
+			Data:  match[5],
+		})
+	}
+
+	return records, nil
+}
+
+// LookupDNSResolverCache returns the cached records for the name, the cache dump is filtered on the firewall as it can be large.
+func (pf *Client) LookupDNSResolverCache(ctx context.Context, name string) (*DNSResolverCacheRecords, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w DNS resolver cache, %w, name is required", ErrGetOperationFailed, ErrClientValidation)
+	}
+
+	fqdn := strings.TrimSuffix(name, ".") + "."
+
+	command := fmt.Sprintf("$name = base64_decode('%s');", base64.StdEncoding.EncodeToString([]byte(fqdn))) +
+		fmt.Sprintf("exec('%s -c %s dump_cache 2>&1', $output, $return_code);", dnsResolverControlPath, dnsResolverConfigPath) +
+		"$records = array(); $in_rrset = false;" +
+		"foreach ($return_code === 0 ? $output : array() as $line) {" +
+		"if ($line === 'START_RRSET_CACHE') { $in_rrset = true; continue; };" +
+		"if ($line === 'END_RRSET_CACHE') { $in_rrset = false; continue; };" +
+		"$fields = preg_split('/\\s+/', $line);" +
+		"if ($in_rrset && strcasecmp($fields[0], $name) === 0) { array_push($records, $line); };" +
+		"};" +
+		"print_r(json_encode(array('output' => $return_code === 0 ? $records : $output, 'return_code' => $return_code)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver cache, %w", ErrGetOperationFailed, err)
+	}
+
+	var controlResp dnsResolverControlResponse
+	err = json.Unmarshal(b, &controlResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver cache, %w, %w", ErrGetOperationFailed, ErrUnableToParse, err)
+	}
+
+	if controlResp.ReturnCode != 0 {
+		return nil, fmt.Errorf("%w DNS resolver cache, %w, '%s'", ErrGetOperationFailed, ErrDNSResolverControl, strings.Join(removeEmptyStrings(controlResp.Output), ", "))
+	}
+
+	records, err := ParseDNSResolverCacheRecords(controlResp.Output)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver cache, %w", ErrGetOperationFailed, err)
+	}
+
+	return &records, nil
+}
@@ -13,8 +13,10 @@ import (
 )
 
 const (
+	dnsResolverDir           = "/var/unbound"
 	dnsResolverConfigFileDir = "/var/unbound/conf.d"
 	dnsResolverConfigFileExt = "conf"
+	dnsResolverCheckConfPath = "/usr/local/sbin/unbound-checkconf"
 )
 
 type configFileResponse struct {
@@ -22,6 +24,13 @@ type configFileResponse struct {
 	Content string `json:"content"`
 }
 
+type configFileCheckResponse struct {
+	Path       string   `json:"path"`
+	MainPath   string   `json:"main_path"`
+	Output     []string `json:"output"`
+	ReturnCode int      `json:"return_code"`
+}
+
 type ConfigFile struct {
 	Name    string
 	Content string
@@ -120,7 +129,49 @@ func (pf *Client) GetDNSResolverConfigFile(ctx context.Context, name string) (*C
 	return configFiles.GetByName(name)
 }
 
-func (pf *Client) createOrUpdateDNSResolverConfigFile(ctx context.Context, configFileReq ConfigFile) (*ConfigFile, error) {
+// the candidate is checked as an include of a minimal main config, as the resolver includes files in the config directory.
+func (pf *Client) checkDNSResolverConfigFile(ctx context.Context, configFileReq ConfigFile) error {
+	command := "$path = tempnam('/tmp', 'unbound-checkconf-');" +
+		fmt.Sprintf("file_put_contents($path, base64_decode('%s'));", configFileReq.formatContent()) +
+		"$main_path = $path . '-main';" +
+		fmt.Sprintf(`file_put_contents($main_path, "server:\n\tchroot: \"\"\n\tdirectory: \"%s\"\ninclude-toplevel: \"{$path}\"\n");`, dnsResolverDir) +
+		fmt.Sprintf("exec('%s ' . escapeshellarg($main_path) . ' 2>&1', $output, $return_code);", dnsResolverCheckConfPath) +
+		"unlink($path); unlink($main_path);" +
+		"print_r(json_encode(array('path' => $path, 'main_path' => $main_path, 'output' => $output, 'return_code' => $return_code)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return err
+	}
+
+	var checkResp configFileCheckResponse
+	err = json.Unmarshal(b, &checkResp)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	if checkResp.ReturnCode == 0 {
+		return nil
+	}
+
+	// report errors against the real file name, line numbers from the candidate file match the requested content.
+	var messages []string
+	for _, line := range checkResp.Output {
+		if line == "" || strings.Contains(line, checkResp.MainPath) {
+			continue
+		}
+
+		messages = append(messages, strings.ReplaceAll(line, checkResp.Path, configFileReq.formatFileName()))
+	}
+
+	if len(messages) == 0 {
+		messages = append(messages, fmt.Sprintf("unbound-checkconf exited with code %d", checkResp.ReturnCode))
+	}
+
+	return fmt.Errorf("%w, '%s'", ErrServerValidation, strings.Join(messages, ", "))
+}
+
+func (pf *Client) writeDNSResolverConfigFile(ctx context.Context, configFileReq ConfigFile) error {
 	u := url.URL{Path: "diag_edit.php"}
 	v := url.Values{
 		"file":   {configFileReq.formatFileName()},
@@ -130,7 +181,7 @@ func (pf *Client) createOrUpdateDNSResolverConfigFile(ctx context.Context, confi
 
 	resp, err := pf.call(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	defer resp.Body.Close()
@@ -138,16 +189,30 @@ func (pf *Client) createOrUpdateDNSResolverConfigFile(ctx context.Context, confi
 	b, err := io.ReadAll(resp.Body)
 	_, _ = io.Copy(io.Discard, resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	message, err := sanitizeHTMLMessage(strings.Trim(string(b), "|"))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if !strings.Contains(message, "success") {
-		return nil, fmt.Errorf("%w '%s'", ErrServerValidation, message)
+		return fmt.Errorf("%w '%s'", ErrServerValidation, message)
+	}
+
+	return nil
+}
+
+func (pf *Client) createOrUpdateDNSResolverConfigFile(ctx context.Context, configFileReq ConfigFile) (*ConfigFile, error) {
+	err := pf.checkDNSResolverConfigFile(ctx, configFileReq)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pf.writeDNSResolverConfigFile(ctx, configFileReq)
+	if err != nil {
+		return nil, err
 	}
 
 	configFiles, err := pf.getDNSResolverConfigFiles(ctx)
@@ -179,10 +244,10 @@ func (pf *Client) UpdateDNSResolverConfigFile(ctx context.Context, configFileReq
 	return cf, nil
 }
 
-func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string) error {
+func (pf *Client) deleteDNSResolverConfigFile(ctx context.Context, name string) error {
 	var cf ConfigFile
 	if err := cf.SetName(name); err != nil {
-		return fmt.Errorf("%w config file, %w", ErrDeleteOperationFailed, err)
+		return err
 	}
 
 	u := url.URL{Path: "diag_command.php"}
@@ -192,9 +257,35 @@ func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string)
 	}
 
 	_, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string) error {
+	err := pf.deleteDNSResolverConfigFile(ctx, name)
 	if err != nil {
 		return fmt.Errorf("%w config file, %w", ErrDeleteOperationFailed, err)
 	}
 
 	return nil
 }
+
+// RestoreDNSResolverConfigFile puts back the previous content of a config file without checking it, a nil previous
+// config file removes the file. Used to roll back a change when applying it fails.
+func (pf *Client) RestoreDNSResolverConfigFile(ctx context.Context, name string, previous *ConfigFile) error {
+	var err error
+	if previous == nil {
+		err = pf.deleteDNSResolverConfigFile(ctx, name)
+	} else {
+		err = pf.writeDNSResolverConfigFile(ctx, *previous)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w config file (name '%s'), %w", ErrUpdateOperationFailed, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrDNSResolverControl = errors.New("unbound-control failed")
+)
+
+type dnsResolverControlResponse struct {
+	Output     []string `json:"output"`
+	ReturnCode int      `json:"return_code"`
+}
+
+func (pf *Client) runDNSResolverControl(ctx context.Context, args ...string) ([]string, error) {
+	command := fmt.Sprintf("$command = '%s -c %s';", dnsResolverControlPath, dnsResolverConfigPath)
+	for _, arg := range args {
+		command += fmt.Sprintf("$command .= ' ' . escapeshellarg(base64_decode('%s'));", base64.StdEncoding.EncodeToString([]byte(arg)))
+	}
+
+	command += "exec($command . ' 2>&1', $output, $return_code);" +
+		"print_r(json_encode(array('output' => $output, 'return_code' => $return_code)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var controlResp dnsResolverControlResponse
+	err = json.Unmarshal(b, &controlResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	if controlResp.ReturnCode != 0 {
+		return nil, fmt.Errorf("%w, '%s'", ErrDNSResolverControl, strings.Join(removeEmptyStrings(controlResp.Output), ", "))
+	}
+
+	return controlResp.Output, nil
+}
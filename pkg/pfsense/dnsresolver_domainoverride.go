@@ -11,8 +11,6 @@ import (
 	"strings"
 )
 
-// TODO pfSense allows for more than one domain override entry with the same domain
-
 const (
 	DefaultDNSPort    = 53
 	DefaultTLSDNSPort = 853
@@ -26,17 +24,23 @@ type domainOverrideResponse struct {
 	Description string  `json:"descr"`
 }
 
+// DomainOverride is the ordered set of lookup servers for a domain, pfSense stores one domain override entry per server.
 type DomainOverride struct {
 	Domain      string
+	Servers     []DomainOverrideServer
+	Description string
+	controlIDs  []int
+}
+
+type DomainOverrideServer struct {
 	IPAddress   netip.AddrPort
 	TLSQueries  bool
 	TLSHostname string
-	Description string
 }
 
-func (do DomainOverride) formatIPAddress() string {
-	addr := do.IPAddress.Addr().String()
-	port := strconv.Itoa(int(do.IPAddress.Port()))
+func (dos DomainOverrideServer) formatIPAddress() string {
+	addr := dos.IPAddress.Addr().String()
+	port := strconv.Itoa(int(dos.IPAddress.Port()))
 	return strings.Join([]string{addr, port}, "@")
 }
 
@@ -46,32 +50,64 @@ func (do *DomainOverride) SetDomain(domain string) error {
 	return nil
 }
 
-// TODO support address without port specified (default to 53/853)
-func (do *DomainOverride) SetIPAddress(ipAddress string) error {
-	addr, err := netip.ParseAddrPort(ipAddress)
-	if err != nil {
-		return err
+func (do *DomainOverride) SetDescription(description string) error {
+	do.Description = description
+
+	return nil
+}
+
+func (do *DomainOverride) SetServers(servers []DomainOverrideServer) error {
+	if len(servers) == 0 {
+		return fmt.Errorf("%w, at least one server is required", ErrClientValidation)
+	}
+
+	seen := make(map[netip.AddrPort]bool, len(servers))
+	for _, server := range servers {
+		if seen[server.IPAddress] {
+			return fmt.Errorf("%w, duplicate server '%s'", ErrClientValidation, server.IPAddress)
+		}
+
+		seen[server.IPAddress] = true
 	}
 
-	do.IPAddress = addr
+	do.Servers = servers
 
 	return nil
 }
 
-func (do *DomainOverride) SetTLSQueries(value bool) error {
-	do.TLSQueries = value
+// SetIPAddress sets the address and port of the server, the port defaults to 53 (or 853 when TLS queries are enabled) when not specified.
+func (dos *DomainOverrideServer) SetIPAddress(ipAddress string, port *int) error {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return err
+	}
+
+	p := DefaultDNSPort
+	if dos.TLSQueries {
+		p = DefaultTLSDNSPort
+	}
+
+	if port != nil {
+		p = *port
+	}
+
+	if p < 1 || p > 65535 {
+		return fmt.Errorf("%w, port '%d' must be between 1 and 65535", ErrClientValidation, p)
+	}
+
+	dos.IPAddress = netip.AddrPortFrom(addr.Unmap(), uint16(p))
 
 	return nil
 }
 
-func (do *DomainOverride) SetDescription(description string) error {
-	do.Description = description
+func (dos *DomainOverrideServer) SetTLSQueries(value bool) error {
+	dos.TLSQueries = value
 
 	return nil
 }
 
-func (do *DomainOverride) SetTLSHostname(hostname string) error {
-	do.TLSHostname = hostname
+func (dos *DomainOverrideServer) SetTLSHostname(hostname string) error {
+	dos.TLSHostname = hostname
 
 	return nil
 }
@@ -87,15 +123,54 @@ func (dos DomainOverrides) GetByDomain(domain string) (*DomainOverride, error) {
 	return nil, fmt.Errorf("domain override %w with domain '%s'", ErrNotFound, domain)
 }
 
-func (dos DomainOverrides) GetControlIDByDomain(domain string) (*int, error) {
-	for i, do := range dos {
+func (dos DomainOverrides) GetControlIDsByDomain(domain string) ([]int, error) {
+	for _, do := range dos {
 		if do.Domain == domain {
-			return &i, nil
+			return do.controlIDs, nil
 		}
 	}
 	return nil, fmt.Errorf("domain override %w with domain '%s'", ErrNotFound, domain)
 }
 
+func parseDomainOverrideServerResponse(resp domainOverrideResponse) (*DomainOverrideServer, error) {
+	var server DomainOverrideServer
+	var err error
+
+	if resp.TLSQueries != nil {
+		err = server.SetTLSQueries(true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addr := resp.IPAddress
+	var port *int
+
+	index := strings.LastIndex(resp.IPAddress, "@")
+	if index != -1 {
+		addr = resp.IPAddress[:index]
+
+		p, err := strconv.Atoi(resp.IPAddress[index+1:])
+		if err != nil {
+			return nil, fmt.Errorf("port '%s', %w", resp.IPAddress[index+1:], err)
+		}
+
+		port = &p
+	}
+
+	err = server.SetIPAddress(addr, port)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.SetTLSHostname(resp.TLSHostname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
 func (pf *Client) getDNSResolverDomainOverrides(ctx context.Context) (*DomainOverrides, error) {
 	b, err := pf.getConfigJSON(ctx, "['unbound']['domainoverrides']")
 	if err != nil {
@@ -109,50 +184,35 @@ func (pf *Client) getDNSResolverDomainOverrides(ctx context.Context) (*DomainOve
 	}
 
 	var domainOverrides DomainOverrides
-	for _, resp := range doResp {
-		var domainOverride DomainOverride
-		var err error
+	indexes := map[string]int{}
 
-		err = domainOverride.SetDomain(resp.Domain)
+	for controlID, resp := range doResp {
+		server, err := parseDomainOverrideServerResponse(resp)
 		if err != nil {
 			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
 		}
 
-		addr := resp.IPAddress
-		port := strconv.Itoa(DefaultDNSPort)
-		if resp.TLSQueries != nil {
-			port = strconv.Itoa(DefaultTLSDNSPort)
-		}
-
-		index := strings.LastIndex(resp.IPAddress, "@")
-		if index != -1 {
-			addr = resp.IPAddress[:index]
-			port = resp.IPAddress[index+1:]
-		}
-
-		err = domainOverride.SetIPAddress(strings.Join([]string{addr, port}, ":"))
-		if err != nil {
-			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
-		}
+		i, ok := indexes[resp.Domain]
+		if !ok {
+			var domainOverride DomainOverride
 
-		if resp.TLSQueries != nil {
-			err = domainOverride.SetTLSQueries(true)
+			err = domainOverride.SetDomain(resp.Domain)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
 			}
-		}
 
-		err = domainOverride.SetTLSHostname(resp.TLSHostname)
-		if err != nil {
-			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
-		}
+			err = domainOverride.SetDescription(resp.Description)
+			if err != nil {
+				return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
+			}
 
-		err = domainOverride.SetDescription(resp.Description)
-		if err != nil {
-			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
+			i = len(domainOverrides)
+			indexes[resp.Domain] = i
+			domainOverrides = append(domainOverrides, domainOverride)
 		}
 
-		domainOverrides = append(domainOverrides, domainOverride)
+		domainOverrides[i].Servers = append(domainOverrides[i].Servers, *server)
+		domainOverrides[i].controlIDs = append(domainOverrides[i].controlIDs, controlID)
 	}
 
 	return &domainOverrides, nil
@@ -182,17 +242,17 @@ func (pf *Client) GetDNSResolverDomainOverride(ctx context.Context, domain strin
 	return domainOverrides.GetByDomain(domain)
 }
 
-func (pf *Client) createOrUpdateDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride, controlID *int) (*DomainOverride, error) {
+func (pf *Client) postDNSResolverDomainOverrideEntry(ctx context.Context, domainOverrideReq DomainOverride, server DomainOverrideServer, controlID *int) error {
 	u := url.URL{Path: "services_unbound_domainoverride_edit.php"}
 	v := url.Values{
 		"domain":       {domainOverrideReq.Domain},
-		"ip":           {domainOverrideReq.formatIPAddress()},
-		"tls_hostname": {domainOverrideReq.TLSHostname},
+		"ip":           {server.formatIPAddress()},
+		"tls_hostname": {server.TLSHostname},
 		"descr":        {domainOverrideReq.Description},
 		"save":         {"Save"},
 	}
 
-	if domainOverrideReq.TLSQueries {
+	if server.TLSQueries {
 		v.Set("forward_tls_upstream", "yes")
 	}
 
@@ -204,32 +264,88 @@ func (pf *Client) createOrUpdateDNSResolverDomainOverride(ctx context.Context, d
 
 	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = scrapeHTMLValidationErrors(doc)
-	if err != nil {
-		return nil, err
-	}
+	return scrapeHTMLValidationErrors(doc)
+}
 
-	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
-	if err != nil {
-		return nil, err
+func (pf *Client) deleteDNSResolverDomainOverrideEntry(ctx context.Context, controlID int) error {
+	u := url.URL{Path: "services_unbound.php"}
+	v := url.Values{
+		"type": {"doverride"},
+		"act":  {"del"},
+		"id":   {strconv.Itoa(controlID)},
 	}
 
-	domainOverride, err := domainOverrides.GetByDomain(domainOverrideReq.Domain)
-	if err != nil {
-		return nil, err
+	_, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+
+	return err
+}
+
+// saving or deleting an entry may renumber the others, control IDs are re-read after every change.
+func (pf *Client) syncDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride) (*DomainOverride, error) {
+	for i, server := range domainOverrideReq.Servers {
+		var controlID *int
+
+		domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if controlIDs, err := domainOverrides.GetControlIDsByDomain(domainOverrideReq.Domain); err == nil && i < len(controlIDs) {
+			controlID = &controlIDs[i]
+		}
+
+		err = pf.postDNSResolverDomainOverrideEntry(ctx, domainOverrideReq, server, controlID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return domainOverride, nil
+	previous := -1
+	for {
+		domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		controlIDs, err := domainOverrides.GetControlIDsByDomain(domainOverrideReq.Domain)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(controlIDs) <= len(domainOverrideReq.Servers) {
+			return domainOverrides.GetByDomain(domainOverrideReq.Domain)
+		}
+
+		if len(controlIDs) == previous {
+			return nil, fmt.Errorf("domain override entry (id '%d') was not removed", controlIDs[len(controlIDs)-1])
+		}
+
+		previous = len(controlIDs)
+
+		err = pf.deleteDNSResolverDomainOverrideEntry(ctx, controlIDs[len(controlIDs)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
 }
 
 func (pf *Client) CreateDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride) (*DomainOverride, error) {
 	pf.mutexes.DNSResolverDomainOverride.Lock()
 	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
 
-	domainOverride, err := pf.createOrUpdateDNSResolverDomainOverride(ctx, domainOverrideReq, nil)
+	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := domainOverrides.GetByDomain(domainOverrideReq.Domain); err == nil {
+		return nil, fmt.Errorf("%w domain override, %w, domain override with domain '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, domainOverrideReq.Domain)
+	}
+
+	domainOverride, err := pf.syncDNSResolverDomainOverride(ctx, domainOverrideReq)
 	if err != nil {
 		return nil, fmt.Errorf("%w domain override, %w", ErrCreateOperationFailed, err)
 	}
@@ -246,12 +362,12 @@ func (pf *Client) UpdateDNSResolverDomainOverride(ctx context.Context, domainOve
 		return nil, fmt.Errorf("%w domain override, %w", ErrUpdateOperationFailed, err)
 	}
 
-	controlID, err := domainOverrides.GetControlIDByDomain(domainOverrideReq.Domain)
+	_, err = domainOverrides.GetByDomain(domainOverrideReq.Domain)
 	if err != nil {
 		return nil, fmt.Errorf("%w domain override, %w", ErrUpdateOperationFailed, err)
 	}
 
-	domainOverride, err := pf.createOrUpdateDNSResolverDomainOverride(ctx, domainOverrideReq, controlID)
+	domainOverride, err := pf.syncDNSResolverDomainOverride(ctx, domainOverrideReq)
 	if err != nil {
 		return nil, fmt.Errorf("%w domain override, %w", ErrUpdateOperationFailed, err)
 	}
@@ -259,6 +375,7 @@ func (pf *Client) UpdateDNSResolverDomainOverride(ctx context.Context, domainOve
 	return domainOverride, nil
 }
 
+// DeleteDNSResolverDomainOverride removes every entry of the domain, starting from the last.
 func (pf *Client) DeleteDNSResolverDomainOverride(ctx context.Context, domain string) error {
 	pf.mutexes.DNSResolverDomainOverride.Lock()
 	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
@@ -268,21 +385,29 @@ func (pf *Client) DeleteDNSResolverDomainOverride(ctx context.Context, domain st
 		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
 	}
 
-	controlID, err := domainOverrides.GetControlIDByDomain(domain)
+	controlIDs, err := domainOverrides.GetControlIDsByDomain(domain)
 	if err != nil {
 		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
 	}
 
-	u := url.URL{Path: "services_unbound.php"}
-	v := url.Values{
-		"type": {"doverride"},
-		"act":  {"del"},
-		"id":   {strconv.Itoa(*controlID)},
-	}
+	for len(controlIDs) > 0 {
+		previous := len(controlIDs)
 
-	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
-	if err != nil {
-		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
+		err = pf.deleteDNSResolverDomainOverrideEntry(ctx, controlIDs[len(controlIDs)-1])
+		if err != nil {
+			return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
+		}
+
+		domainOverrides, err = pf.getDNSResolverDomainOverrides(ctx)
+		if err != nil {
+			return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
+		}
+
+		controlIDs, _ = domainOverrides.GetControlIDsByDomain(domain)
+
+		if len(controlIDs) == previous {
+			return fmt.Errorf("%w domain override, entry (id '%d') was not removed", ErrDeleteOperationFailed, controlIDs[len(controlIDs)-1])
+		}
 	}
 
 	return nil
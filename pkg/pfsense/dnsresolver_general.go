@@ -0,0 +1,313 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DNSResolverInterfaceAll = "all"
+
+	LocalZoneTypeDeny            = "deny"
+	LocalZoneTypeRefuse          = "refuse"
+	LocalZoneTypeStatic          = "static"
+	LocalZoneTypeTransparent     = "transparent"
+	LocalZoneTypeTypeTransparent = "typetransparent"
+	LocalZoneTypeRedirect        = "redirect"
+	LocalZoneTypeInform          = "inform"
+	LocalZoneTypeInformDeny      = "inform_deny"
+	LocalZoneTypeNoDefault       = "nodefault"
+)
+
+var LocalZoneTypes = []string{
+	LocalZoneTypeDeny,
+	LocalZoneTypeRefuse,
+	LocalZoneTypeStatic,
+	LocalZoneTypeTransparent,
+	LocalZoneTypeTypeTransparent,
+	LocalZoneTypeRedirect,
+	LocalZoneTypeInform,
+	LocalZoneTypeInformDeny,
+	LocalZoneTypeNoDefault,
+}
+
+const dnsResolverFlag = "yes"
+
+type dnsResolverGeneralResponse struct {
+	Enabled                    bool   `json:"enable"`
+	ListenInterfaces           string `json:"active_interface"`
+	OutgoingInterfaces         string `json:"outgoing_interface"`
+	SystemDomainLocalZoneType  string `json:"system_domain_local_zone_type"`
+	DNSSEC                     bool   `json:"dnssec"`
+	Forwarding                 bool   `json:"forwarding"`
+	RegisterDHCPLeases         bool   `json:"regdhcp"`
+	RegisterDHCPStaticMappings bool   `json:"regdhcpstatic"`
+	RegisterOpenVPNClients     bool   `json:"regovpnclients"`
+	CustomOptions              string `json:"custom_options"`
+}
+
+// DNSResolverGeneral is the subset of services_unbound.php settings managed by the provider.
+type DNSResolverGeneral struct {
+	Enabled                    bool
+	ListenInterfaces           []string
+	OutgoingInterfaces         []string
+	SystemDomainLocalZoneType  string
+	DNSSEC                     bool
+	Forwarding                 bool
+	RegisterDHCPLeases         bool
+	RegisterDHCPStaticMappings bool
+	RegisterOpenVPNClients     bool
+	CustomOptions              string
+}
+
+func validateDNSResolverInterfaces(kind string, interfaces []string) error {
+	if len(interfaces) == 0 {
+		return fmt.Errorf("%w, at least one %s interface is required", ErrClientValidation, kind)
+	}
+
+	seen := make(map[string]bool, len(interfaces))
+	for _, iface := range interfaces {
+		if iface == "" {
+			return fmt.Errorf("%w, %s interface cannot be empty", ErrClientValidation, kind)
+		}
+
+		if iface == DNSResolverInterfaceAll && len(interfaces) > 1 {
+			return fmt.Errorf("%w, %s interface '%s' cannot be combined with other interfaces", ErrClientValidation, kind, DNSResolverInterfaceAll)
+		}
+
+		if seen[iface] {
+			return fmt.Errorf("%w, duplicate %s interface '%s'", ErrClientValidation, kind, iface)
+		}
+
+		seen[iface] = true
+	}
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetEnabled(enabled bool) error {
+	gen.Enabled = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetListenInterfaces(interfaces []string) error {
+	err := validateDNSResolverInterfaces("listen", interfaces)
+	if err != nil {
+		return err
+	}
+
+	gen.ListenInterfaces = interfaces
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetOutgoingInterfaces(interfaces []string) error {
+	err := validateDNSResolverInterfaces("outgoing", interfaces)
+	if err != nil {
+		return err
+	}
+
+	gen.OutgoingInterfaces = interfaces
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetSystemDomainLocalZoneType(zoneType string) error {
+	err := validateChoice("system domain local zone type", zoneType, LocalZoneTypes)
+	if err != nil {
+		return err
+	}
+
+	gen.SystemDomainLocalZoneType = zoneType
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetDNSSEC(enabled bool) error {
+	gen.DNSSEC = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetForwarding(enabled bool) error {
+	gen.Forwarding = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetRegisterDHCPLeases(enabled bool) error {
+	gen.RegisterDHCPLeases = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetRegisterDHCPStaticMappings(enabled bool) error {
+	gen.RegisterDHCPStaticMappings = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetRegisterOpenVPNClients(enabled bool) error {
+	gen.RegisterOpenVPNClients = enabled
+
+	return nil
+}
+
+func (gen *DNSResolverGeneral) SetCustomOptions(options string) error {
+	gen.CustomOptions = strings.ReplaceAll(options, "\r\n", "\n")
+
+	return nil
+}
+
+// an empty list is shown as all interfaces by the page.
+func parseDNSResolverInterfaces(s string) []string {
+	if s == "" {
+		return []string{DNSResolverInterfaceAll}
+	}
+
+	return strings.Split(s, ",")
+}
+
+func parseDNSResolverGeneralResponse(resp dnsResolverGeneralResponse) (*DNSResolverGeneral, error) {
+	var gen DNSResolverGeneral
+	var err error
+
+	err = gen.SetListenInterfaces(parseDNSResolverInterfaces(resp.ListenInterfaces))
+	if err != nil {
+		return nil, err
+	}
+
+	err = gen.SetOutgoingInterfaces(parseDNSResolverInterfaces(resp.OutgoingInterfaces))
+	if err != nil {
+		return nil, err
+	}
+
+	zoneType := resp.SystemDomainLocalZoneType
+	if zoneType == "" {
+		zoneType = LocalZoneTypeTransparent
+	}
+
+	err = gen.SetSystemDomainLocalZoneType(zoneType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gen.SetCustomOptions(resp.CustomOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	gen.Enabled = resp.Enabled
+	gen.DNSSEC = resp.DNSSEC
+	gen.Forwarding = resp.Forwarding
+	gen.RegisterDHCPLeases = resp.RegisterDHCPLeases
+	gen.RegisterDHCPStaticMappings = resp.RegisterDHCPStaticMappings
+	gen.RegisterOpenVPNClients = resp.RegisterOpenVPNClients
+
+	return &gen, nil
+}
+
+func (pf *Client) getDNSResolverGeneral(ctx context.Context) (*DNSResolverGeneral, error) {
+	command := "$u = $config['unbound'] ?? array();" +
+		"print_r(json_encode(array(" +
+		"'enable' => isset($u['enable'])," +
+		"'active_interface' => $u['active_interface'] ?? ''," +
+		"'outgoing_interface' => $u['outgoing_interface'] ?? ''," +
+		"'system_domain_local_zone_type' => $u['system_domain_local_zone_type'] ?? ''," +
+		"'dnssec' => isset($u['dnssec'])," +
+		"'forwarding' => isset($u['forwarding'])," +
+		"'regdhcp' => isset($u['regdhcp'])," +
+		"'regdhcpstatic' => isset($u['regdhcpstatic'])," +
+		"'regovpnclients' => isset($u['regovpnclients'])," +
+		"'custom_options' => base64_decode($u['custom_options'] ?? ''))));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var genResp dnsResolverGeneralResponse
+	err = json.Unmarshal(b, &genResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	gen, err := parseDNSResolverGeneralResponse(genResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general response, %w", ErrUnableToParse, err)
+	}
+
+	return gen, nil
+}
+
+func (pf *Client) GetDNSResolverGeneral(ctx context.Context) (*DNSResolverGeneral, error) {
+	pf.mutexes.DNSResolverGeneral.Lock()
+	defer pf.mutexes.DNSResolverGeneral.Unlock()
+
+	gen, err := pf.getDNSResolverGeneral(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return gen, nil
+}
+
+func setDNSResolverFlag(v url.Values, name string, enabled bool) {
+	if enabled {
+		v.Set(name, dnsResolverFlag)
+	} else {
+		v.Del(name)
+	}
+}
+
+// UpdateDNSResolverGeneral saves the settings, the page resets fields which are not submitted so all other fields are posted as currently shown.
+func (pf *Client) UpdateDNSResolverGeneral(ctx context.Context, genReq DNSResolverGeneral) (*DNSResolverGeneral, error) {
+	pf.mutexes.DNSResolverGeneral.Lock()
+	defer pf.mutexes.DNSResolverGeneral.Unlock()
+
+	u := url.URL{Path: "services_unbound.php"}
+
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v, err := scrapeHTMLFormValues(doc, "form.form-horizontal")
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	setDNSResolverFlag(v, "enable", genReq.Enabled)
+	v["active_interface[]"] = genReq.ListenInterfaces
+	v["outgoing_interface[]"] = genReq.OutgoingInterfaces
+	v.Set("system_domain_local_zone_type", genReq.SystemDomainLocalZoneType)
+	setDNSResolverFlag(v, "dnssec", genReq.DNSSEC)
+	setDNSResolverFlag(v, "forwarding", genReq.Forwarding)
+	setDNSResolverFlag(v, "regdhcp", genReq.RegisterDHCPLeases)
+	setDNSResolverFlag(v, "regdhcpstatic", genReq.RegisterDHCPStaticMappings)
+	setDNSResolverFlag(v, "regovpnclients", genReq.RegisterOpenVPNClients)
+	v.Set("custom_options", genReq.CustomOptions)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	gen, err := pf.getDNSResolverGeneral(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver general settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return gen, nil
+}
@@ -2,7 +2,10 @@ package pfsense
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/netip"
@@ -11,6 +14,11 @@ import (
 	"strings"
 )
 
+var (
+	ErrDuplicateHostOverride = errors.New("duplicate host override")
+	ErrHostOverrideChanged   = errors.New("host override changed")
+)
+
 type hostOverrideResponse struct {
 	Host        string                        `json:"host"`
 	Domain      string                        `json:"domain"`
@@ -35,6 +43,7 @@ type HostOverride struct {
 	IPAddresses []netip.Addr
 	Description string
 	Aliases     []HostOverrideAlias
+	controlID   int
 }
 
 type HostOverrideAlias struct {
@@ -67,6 +76,36 @@ func (ho HostOverride) FQDN() string {
 	return strings.Join(removeEmptyStrings([]string{ho.Host, ho.Domain}), ".")
 }
 
+// Fingerprint identifies the content of the host override, pfSense re-sorts host overrides and renumbers them whenever one is
+// added or removed so the entry is found again by FQDN rather than by its index.
+func (ho HostOverride) Fingerprint() (string, error) {
+	b, err := json.Marshal(ho)
+	if err != nil {
+		return "", fmt.Errorf("unable to fingerprint host override with FQDN '%s', %w", ho.FQDN(), err)
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (ho HostOverride) checkFingerprint(fingerprint string) error {
+	if fingerprint == "" {
+		return nil
+	}
+
+	current, err := ho.Fingerprint()
+	if err != nil {
+		return err
+	}
+
+	if current != fingerprint {
+		return fmt.Errorf("%w, host override with FQDN '%s' was modified outside of the provider since it was last read", ErrHostOverrideChanged, ho.FQDN())
+	}
+
+	return nil
+}
+
 func (hoa HostOverrideAlias) FQDN() string {
 	return strings.Join([]string{hoa.Host, hoa.Domain}, ".")
 }
@@ -121,22 +160,32 @@ func (hoa *HostOverrideAlias) SetDescription(description string) error {
 
 type HostOverrides []HostOverride
 
+// GetByFQDN returns the host override with the FQDN, pfSense allows several entries with the same FQDN which cannot be told apart.
 func (hos HostOverrides) GetByFQDN(fqdn string) (*HostOverride, error) {
+	var matches []HostOverride
 	for _, ho := range hos {
 		if ho.FQDN() == fqdn {
-			return &ho, nil
+			matches = append(matches, ho)
 		}
 	}
-	return nil, fmt.Errorf("host override %w with FQDN '%s'", ErrNotFound, fqdn)
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("host override %w with FQDN '%s'", ErrNotFound, fqdn)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%w, %d host overrides with FQDN '%s'", ErrDuplicateHostOverride, len(matches), fqdn)
+	}
 }
 
 func (hos HostOverrides) GetControlIDByFQDN(fqdn string) (*int, error) {
-	for i, ho := range hos {
-		if ho.FQDN() == fqdn {
-			return &i, nil
-		}
+	ho, err := hos.GetByFQDN(fqdn)
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("host override %w with FQDN '%s'", ErrNotFound, fqdn)
+
+	return &ho.controlID, nil
 }
 
 func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrides, error) {
@@ -152,7 +201,7 @@ func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrid
 	}
 
 	var hostOverrides HostOverrides
-	for _, resp := range hoResp {
+	for controlID, resp := range hoResp {
 		var hostOverride HostOverride
 		var err error
 
@@ -198,6 +247,8 @@ func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrid
 			hostOverride.Aliases = append(hostOverride.Aliases, hostOverrideAlias)
 		}
 
+		hostOverride.controlID = controlID
+
 		hostOverrides = append(hostOverrides, hostOverride)
 	}
 
@@ -228,7 +279,7 @@ func (pf *Client) GetDNSResolverHostOverride(ctx context.Context, fqdn string) (
 	return hostOverrides.GetByFQDN(fqdn)
 }
 
-func (pf *Client) createOrUpdateDNSResolverHostOverride(ctx context.Context, hostOverrideReq HostOverride, controlID *int) (*HostOverride, error) {
+func (pf *Client) createOrUpdateDNSResolverHostOverride(ctx context.Context, hostOverrideReq HostOverride, existing *HostOverride) (*HostOverride, error) {
 	u := url.URL{Path: "services_unbound_host_edit.php"}
 	v := url.Values{
 		"host":   {hostOverrideReq.Host},
@@ -244,9 +295,9 @@ func (pf *Client) createOrUpdateDNSResolverHostOverride(ctx context.Context, hos
 		v.Set(fmt.Sprintf("aliasdescription%d", i), alias.Description)
 	}
 
-	if controlID != nil {
+	if existing != nil {
 		q := u.Query()
-		q.Set("id", strconv.Itoa(*controlID))
+		q.Set("id", strconv.Itoa(existing.controlID))
 		u.RawQuery = q.Encode()
 	}
 
@@ -277,6 +328,15 @@ func (pf *Client) CreateDNSResolverHostOverride(ctx context.Context, hostOverrid
 	pf.mutexes.DNSResolverHostOverride.Lock()
 	defer pf.mutexes.DNSResolverHostOverride.Unlock()
 
+	hostOverrides, err := pf.getDNSResolverHostOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w host override, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := hostOverrides.GetByFQDN(hostOverrideReq.FQDN()); !errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("%w host override, %w with FQDN '%s'", ErrCreateOperationFailed, ErrDuplicateHostOverride, hostOverrideReq.FQDN())
+	}
+
 	hostOverride, err := pf.createOrUpdateDNSResolverHostOverride(ctx, hostOverrideReq, nil)
 	if err != nil {
 		return nil, fmt.Errorf("%w host override, %w", ErrCreateOperationFailed, err)
@@ -285,7 +345,9 @@ func (pf *Client) CreateDNSResolverHostOverride(ctx context.Context, hostOverrid
 	return hostOverride, nil
 }
 
-func (pf *Client) UpdateDNSResolverHostOverride(ctx context.Context, hostOverrideReq HostOverride) (*HostOverride, error) {
+// UpdateDNSResolverHostOverride updates the host override currently named by the FQDN, the request may change the host and domain.
+// The update is refused when the content no longer matches the fingerprint, an empty fingerprint is not checked.
+func (pf *Client) UpdateDNSResolverHostOverride(ctx context.Context, fqdn string, fingerprint string, hostOverrideReq HostOverride) (*HostOverride, error) {
 	pf.mutexes.DNSResolverHostOverride.Lock()
 	defer pf.mutexes.DNSResolverHostOverride.Unlock()
 
@@ -294,12 +356,23 @@ func (pf *Client) UpdateDNSResolverHostOverride(ctx context.Context, hostOverrid
 		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
 	}
 
-	controlID, err := hostOverrides.GetControlIDByFQDN(hostOverrideReq.FQDN())
+	existing, err := hostOverrides.GetByFQDN(fqdn)
 	if err != nil {
 		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
 	}
 
-	hostOverride, err := pf.createOrUpdateDNSResolverHostOverride(ctx, hostOverrideReq, controlID)
+	err = existing.checkFingerprint(fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if hostOverrideReq.FQDN() != fqdn {
+		if _, err := hostOverrides.GetByFQDN(hostOverrideReq.FQDN()); !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("%w host override, %w with FQDN '%s'", ErrUpdateOperationFailed, ErrDuplicateHostOverride, hostOverrideReq.FQDN())
+		}
+	}
+
+	hostOverride, err := pf.createOrUpdateDNSResolverHostOverride(ctx, hostOverrideReq, existing)
 	if err != nil {
 		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
 	}
@@ -307,7 +380,8 @@ func (pf *Client) UpdateDNSResolverHostOverride(ctx context.Context, hostOverrid
 	return hostOverride, nil
 }
 
-func (pf *Client) DeleteDNSResolverHostOverride(ctx context.Context, fqdn string) error {
+// DeleteDNSResolverHostOverride deletes the host override named by the FQDN, refused when it no longer matches the fingerprint.
+func (pf *Client) DeleteDNSResolverHostOverride(ctx context.Context, fqdn string, fingerprint string) error {
 	pf.mutexes.DNSResolverHostOverride.Lock()
 	defer pf.mutexes.DNSResolverHostOverride.Unlock()
 
@@ -316,7 +390,12 @@ func (pf *Client) DeleteDNSResolverHostOverride(ctx context.Context, fqdn string
 		return fmt.Errorf("%w host override, %w", ErrDeleteOperationFailed, err)
 	}
 
-	controlID, err := hostOverrides.GetControlIDByFQDN(fqdn)
+	existing, err := hostOverrides.GetByFQDN(fqdn)
+	if err != nil {
+		return fmt.Errorf("%w host override, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = existing.checkFingerprint(fingerprint)
 	if err != nil {
 		return fmt.Errorf("%w host override, %w", ErrDeleteOperationFailed, err)
 	}
@@ -325,7 +404,7 @@ func (pf *Client) DeleteDNSResolverHostOverride(ctx context.Context, fqdn string
 	v := url.Values{
 		"type": {"host"},
 		"act":  {"del"},
-		"id":   {strconv.Itoa(*controlID)},
+		"id":   {strconv.Itoa(existing.controlID)},
 	}
 
 	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
@@ -0,0 +1,121 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dnsResolverStatsQueryTypePrefix   = "num.query.type."
+	dnsResolverStatsAnswerRcodePrefix = "num.answer.rcode."
+)
+
+// DNSResolverStats is the totals reported by 'unbound-control stats_noreset', counters are since the resolver started.
+type DNSResolverStats struct {
+	Queries              uint64
+	CacheHits            uint64
+	CacheMisses          uint64
+	Prefetches           uint64
+	ExpiredReplies       uint64
+	RecursiveReplies     uint64
+	RequestListAverage   float64
+	RequestListMax       uint64
+	RecursionTimeAverage time.Duration
+	RecursionTimeMedian  time.Duration
+	Uptime               time.Duration
+	QueryTypes           map[string]uint64
+	AnswerRcodes         map[string]uint64
+	Values               map[string]string
+}
+
+// CacheHitRatio returns the share of queries answered from cache, zero before any queries.
+func (stats DNSResolverStats) CacheHitRatio() float64 {
+	if stats.Queries == 0 {
+		return 0
+	}
+	return float64(stats.CacheHits) / float64(stats.Queries)
+}
+
+func parseDNSResolverStatsSeconds(value string) (time.Duration, error) {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// ParseDNSResolverStats parses the 'name=value' lines of 'unbound-control stats_noreset', per thread lines are kept in Values only.
+func ParseDNSResolverStats(lines []string) (*DNSResolverStats, error) {
+	stats := DNSResolverStats{
+		QueryTypes:   map[string]uint64{},
+		AnswerRcodes: map[string]uint64{},
+		Values:       map[string]string{},
+	}
+
+	counters := map[string]*uint64{
+		"total.num.queries":          &stats.Queries,
+		"total.num.cachehits":        &stats.CacheHits,
+		"total.num.cachemiss":        &stats.CacheMisses,
+		"total.num.prefetch":         &stats.Prefetches,
+		"total.num.expired":          &stats.ExpiredReplies,
+		"total.num.recursivereplies": &stats.RecursiveReplies,
+		"total.requestlist.max":      &stats.RequestListMax,
+	}
+
+	durations := map[string]*time.Duration{
+		"total.recursion.time.avg":    &stats.RecursionTimeAverage,
+		"total.recursion.time.median": &stats.RecursionTimeMedian,
+		"time.up":                     &stats.Uptime,
+	}
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+
+		stats.Values[name] = value
+
+		var err error
+		switch {
+		case counters[name] != nil:
+			*counters[name], err = strconv.ParseUint(value, 10, 64)
+		case durations[name] != nil:
+			*durations[name], err = parseDNSResolverStatsSeconds(value)
+		case name == "total.requestlist.avg":
+			stats.RequestListAverage, err = strconv.ParseFloat(value, 64)
+		case strings.HasPrefix(name, dnsResolverStatsQueryTypePrefix):
+			stats.QueryTypes[strings.TrimPrefix(name, dnsResolverStatsQueryTypePrefix)], err = strconv.ParseUint(value, 10, 64)
+		case strings.HasPrefix(name, dnsResolverStatsAnswerRcodePrefix):
+			stats.AnswerRcodes[strings.TrimPrefix(name, dnsResolverStatsAnswerRcodePrefix)], err = strconv.ParseUint(value, 10, 64)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w stat '%s' value '%s'", ErrUnableToParse, name, value)
+		}
+	}
+
+	if _, ok := stats.Values["total.num.queries"]; !ok {
+		return nil, fmt.Errorf("%w stats, total query count not found", ErrUnableToParse)
+	}
+
+	return &stats, nil
+}
+
+func (pf *Client) GetDNSResolverStats(ctx context.Context) (*DNSResolverStats, error) {
+	lines, err := pf.runDNSResolverControl(ctx, "stats_noreset")
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver stats, %w", ErrGetOperationFailed, err)
+	}
+
+	stats, err := ParseDNSResolverStats(lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver stats, %w", ErrGetOperationFailed, err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,314 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	FirewallOptimizationNormal       = "normal"
+	FirewallOptimizationHighLatency  = "high-latency"
+	FirewallOptimizationAggressive   = "aggressive"
+	FirewallOptimizationConservative = "conservative"
+
+	BogonsUpdateFrequencyMonthly = "monthly"
+	BogonsUpdateFrequencyWeekly  = "weekly"
+	BogonsUpdateFrequencyDaily   = "daily"
+
+	NATReflectionModeDisable = "disable"
+	NATReflectionModeProxy   = "proxy"
+	NATReflectionModePureNAT = "purenat"
+)
+
+var (
+	FirewallOptimizationModes = []string{FirewallOptimizationNormal, FirewallOptimizationHighLatency, FirewallOptimizationAggressive, FirewallOptimizationConservative}
+	BogonsUpdateFrequencies   = []string{BogonsUpdateFrequencyMonthly, BogonsUpdateFrequencyWeekly, BogonsUpdateFrequencyDaily}
+	NATReflectionModes        = []string{NATReflectionModeDisable, NATReflectionModeProxy, NATReflectionModePureNAT}
+)
+
+const firewallAdvancedFlag = "yes"
+
+type firewallAdvancedResponse struct {
+	OptimizationMode               string `json:"optimization"`
+	MaximumStates                  string `json:"maximumstates"`
+	MaximumTableEntries            string `json:"maximumtableentries"`
+	BogonsUpdateFrequency          string `json:"bogonsinterval"`
+	DisableNATReflection           bool   `json:"disablenatreflection"`
+	PureNATReflection              bool   `json:"enablenatreflectionpurenat"`
+	NATReflectionTimeout           string `json:"reflectiontimeout"`
+	OneToOneNATReflection          bool   `json:"enablebinatreflection"`
+	AutomaticOutboundNATReflection bool   `json:"enablenatreflectionhelper"`
+	BypassStaticRoutes             bool   `json:"bypassstaticroutes"`
+	DisableReplyTo                 bool   `json:"disablereplyto"`
+}
+
+// FirewallAdvanced is the subset of system_advanced_firewall.php settings managed by the provider.
+type FirewallAdvanced struct {
+	OptimizationMode               string
+	MaximumStates                  *int
+	MaximumTableEntries            *int
+	BogonsUpdateFrequency          string
+	NATReflectionMode              string
+	NATReflectionTimeout           *int
+	OneToOneNATReflection          bool
+	AutomaticOutboundNATReflection bool
+	StaticRouteFiltering           bool
+	DisableReplyTo                 bool
+}
+
+func (adv *FirewallAdvanced) SetOptimizationMode(mode string) error {
+	err := validateChoice("optimization mode", mode, FirewallOptimizationModes)
+	if err != nil {
+		return err
+	}
+
+	adv.OptimizationMode = mode
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetMaximumStates(states int) error {
+	if states < 1 {
+		return fmt.Errorf("%w, maximum states must be greater than 0", ErrClientValidation)
+	}
+
+	adv.MaximumStates = &states
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetMaximumTableEntries(entries int) error {
+	if entries < 1 {
+		return fmt.Errorf("%w, maximum table entries must be greater than 0", ErrClientValidation)
+	}
+
+	adv.MaximumTableEntries = &entries
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetBogonsUpdateFrequency(frequency string) error {
+	err := validateChoice("bogons update frequency", frequency, BogonsUpdateFrequencies)
+	if err != nil {
+		return err
+	}
+
+	adv.BogonsUpdateFrequency = frequency
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetNATReflectionMode(mode string) error {
+	err := validateChoice("NAT reflection mode", mode, NATReflectionModes)
+	if err != nil {
+		return err
+	}
+
+	adv.NATReflectionMode = mode
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetNATReflectionTimeout(timeout int) error {
+	if timeout < 1 {
+		return fmt.Errorf("%w, NAT reflection timeout must be greater than 0", ErrClientValidation)
+	}
+
+	adv.NATReflectionTimeout = &timeout
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetOneToOneNATReflection(enabled bool) error {
+	adv.OneToOneNATReflection = enabled
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetAutomaticOutboundNATReflection(enabled bool) error {
+	adv.AutomaticOutboundNATReflection = enabled
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetStaticRouteFiltering(enabled bool) error {
+	adv.StaticRouteFiltering = enabled
+
+	return nil
+}
+
+func (adv *FirewallAdvanced) SetDisableReplyTo(disabled bool) error {
+	adv.DisableReplyTo = disabled
+
+	return nil
+}
+
+func parseFirewallAdvancedResponse(resp firewallAdvancedResponse) (*FirewallAdvanced, error) {
+	var adv FirewallAdvanced
+	var err error
+
+	optimization := resp.OptimizationMode
+	if optimization == "" {
+		optimization = FirewallOptimizationNormal
+	}
+
+	err = adv.SetOptimizationMode(optimization)
+	if err != nil {
+		return nil, err
+	}
+
+	bogons := resp.BogonsUpdateFrequency
+	if bogons == "" {
+		bogons = BogonsUpdateFrequencyMonthly
+	}
+
+	err = adv.SetBogonsUpdateFrequency(bogons)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.DisableNATReflection:
+		adv.NATReflectionMode = NATReflectionModeDisable
+	case resp.PureNATReflection:
+		adv.NATReflectionMode = NATReflectionModePureNAT
+	default:
+		adv.NATReflectionMode = NATReflectionModeProxy
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		set   func(int) error
+	}{
+		{"maximum states", resp.MaximumStates, adv.SetMaximumStates},
+		{"maximum table entries", resp.MaximumTableEntries, adv.SetMaximumTableEntries},
+		{"NAT reflection timeout", resp.NATReflectionTimeout, adv.SetNATReflectionTimeout},
+	} {
+		if field.value == "" {
+			continue
+		}
+
+		i, err := strconv.Atoi(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s '%s'", ErrUnableToParse, field.name, field.value)
+		}
+
+		err = field.set(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	adv.OneToOneNATReflection = resp.OneToOneNATReflection
+	adv.AutomaticOutboundNATReflection = resp.AutomaticOutboundNATReflection
+	adv.StaticRouteFiltering = resp.BypassStaticRoutes
+	adv.DisableReplyTo = resp.DisableReplyTo
+
+	return &adv, nil
+}
+
+func (pf *Client) getFirewallAdvanced(ctx context.Context) (*FirewallAdvanced, error) {
+	command := "$s = $config['system'];" +
+		"print_r(json_encode(array(" +
+		"'optimization' => $s['optimization'] ?? ''," +
+		"'maximumstates' => $s['maximumstates'] ?? ''," +
+		"'maximumtableentries' => $s['maximumtableentries'] ?? ''," +
+		"'bogonsinterval' => $s['bogons']['interval'] ?? ''," +
+		"'disablenatreflection' => isset($s['disablenatreflection'])," +
+		"'enablenatreflectionpurenat' => isset($s['enablenatreflectionpurenat'])," +
+		"'reflectiontimeout' => $s['reflectiontimeout'] ?? ''," +
+		"'enablebinatreflection' => isset($s['enablebinatreflection'])," +
+		"'enablenatreflectionhelper' => isset($s['enablenatreflectionhelper'])," +
+		"'bypassstaticroutes' => isset($config['filter']['bypassstaticroutes'])," +
+		"'disablereplyto' => isset($s['disablereplyto']))));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var advResp firewallAdvancedResponse
+	err = json.Unmarshal(b, &advResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	adv, err := parseFirewallAdvancedResponse(advResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced response, %w", ErrUnableToParse, err)
+	}
+
+	return adv, nil
+}
+
+func (pf *Client) GetFirewallAdvanced(ctx context.Context) (*FirewallAdvanced, error) {
+	pf.mutexes.FirewallAdvanced.Lock()
+	defer pf.mutexes.FirewallAdvanced.Unlock()
+
+	adv, err := pf.getFirewallAdvanced(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return adv, nil
+}
+
+func setFirewallAdvancedFlag(v url.Values, name string, enabled bool) {
+	if enabled {
+		v.Set(name, firewallAdvancedFlag)
+	} else {
+		v.Del(name)
+	}
+}
+
+// UpdateFirewallAdvanced saves the settings, the page resets fields which are not submitted so all other fields are posted as currently shown.
+func (pf *Client) UpdateFirewallAdvanced(ctx context.Context, advReq FirewallAdvanced) (*FirewallAdvanced, error) {
+	pf.mutexes.FirewallAdvanced.Lock()
+	defer pf.mutexes.FirewallAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_firewall.php"}
+
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v, err := scrapeHTMLFormValues(doc, "form.form-horizontal")
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v.Set("optimization", advReq.OptimizationMode)
+	v.Set("maximumstates", formatOptionalInt(advReq.MaximumStates))
+	v.Set("maximumtableentries", formatOptionalInt(advReq.MaximumTableEntries))
+	v.Set("bogonsinterval", advReq.BogonsUpdateFrequency)
+	v.Set("natreflection", advReq.NATReflectionMode)
+	v.Set("reflectiontimeout", formatOptionalInt(advReq.NATReflectionTimeout))
+	setFirewallAdvancedFlag(v, "enablebinatreflection", advReq.OneToOneNATReflection)
+	setFirewallAdvancedFlag(v, "enablenatreflectionhelper", advReq.AutomaticOutboundNATReflection)
+	setFirewallAdvancedFlag(v, "bypassstaticroutes", advReq.StaticRouteFiltering)
+	setFirewallAdvancedFlag(v, "disablereplyto", advReq.DisableReplyTo)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	adv, err := pf.getFirewallAdvanced(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall advanced settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return adv, nil
+}
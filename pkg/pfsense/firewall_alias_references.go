@@ -0,0 +1,233 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	FirewallAliasReferenceTypeFilterRule     = "filter_rule"
+	FirewallAliasReferenceTypeNATPortForward = "nat_port_forward"
+	FirewallAliasReferenceTypeNATOutbound    = "nat_outbound"
+	FirewallAliasReferenceTypeNATOneToOne    = "nat_one_to_one"
+	FirewallAliasReferenceTypeAlias          = "alias"
+	FirewallAliasReferenceTypeStaticRoute    = "static_route"
+	FirewallAliasReferenceTypeShaper         = "shaper"
+	FirewallAliasReferenceTypeLimiter        = "limiter"
+)
+
+var (
+	ErrAliasInUse = errors.New("alias in use")
+)
+
+type aliasReferenceEndpointResponse struct {
+	Address string `json:"address"`
+	Network string `json:"network"`
+	Port    string `json:"port"`
+}
+
+func (e *aliasReferenceEndpointResponse) UnmarshalJSON(data []byte) error {
+	if data[0] == '{' {
+		type t aliasReferenceEndpointResponse
+		var resp t
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		*e = aliasReferenceEndpointResponse(resp)
+	}
+	return nil
+}
+
+type aliasReferenceRuleResponse struct {
+	Description string                         `json:"descr"`
+	Source      aliasReferenceEndpointResponse `json:"source"`
+	Destination aliasReferenceEndpointResponse `json:"destination"`
+	SourcePort  string                         `json:"sourceport"`
+	DstPort     string                         `json:"dstport"`
+	Target      string                         `json:"target"`
+	LocalPort   string                         `json:"local-port"`
+	Queue       string                         `json:"defaultqueue"`
+	AckQueue    string                         `json:"ackqueue"`
+	InPipe      string                         `json:"dnpipe"`
+	OutPipe     string                         `json:"pdnpipe"`
+}
+
+type aliasReferenceAliasResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"descr"`
+	Addresses   string `json:"address"`
+}
+
+type aliasReferenceStaticRouteResponse struct {
+	Network     string `json:"network"`
+	Description string `json:"descr"`
+}
+
+type aliasReferenceShaperSettingResponse struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+type aliasReferencesResponse struct {
+	FilterRules     []aliasReferenceRuleResponse          `json:"filter"`
+	NATPortForwards []aliasReferenceRuleResponse          `json:"nat"`
+	NATOutbound     []aliasReferenceRuleResponse          `json:"outbound"`
+	NATOneToOne     []aliasReferenceRuleResponse          `json:"onetoone"`
+	Aliases         []aliasReferenceAliasResponse         `json:"aliases"`
+	StaticRoutes    []aliasReferenceStaticRouteResponse   `json:"staticroutes"`
+	Shaper          []aliasReferenceShaperSettingResponse `json:"shaper"`
+	Limiters        []aliasReferenceShaperSettingResponse `json:"dnshaper"`
+}
+
+type FirewallAliasReference struct {
+	Type        string
+	Index       int
+	Field       string
+	Description string
+}
+
+func (ref FirewallAliasReference) String() string {
+	if ref.Description == "" {
+		return fmt.Sprintf("%s #%d (%s)", ref.Type, ref.Index, ref.Field)
+	}
+	return fmt.Sprintf("%s #%d '%s' (%s)", ref.Type, ref.Index, ref.Description, ref.Field)
+}
+
+type FirewallAliasReferences []FirewallAliasReference
+
+type AliasInUseError struct {
+	Name       string
+	References FirewallAliasReferences
+}
+
+func (e *AliasInUseError) Error() string {
+	var refs []string
+	for _, ref := range e.References {
+		refs = append(refs, ref.String())
+	}
+	return fmt.Sprintf("%s, '%s' is referenced by %s", ErrAliasInUse, e.Name, strings.Join(refs, ", "))
+}
+
+func (e *AliasInUseError) Unwrap() error {
+	return ErrAliasInUse
+}
+
+func (resp aliasReferencesResponse) references(name string) FirewallAliasReferences {
+	var refs FirewallAliasReferences
+
+	add := func(t string, index int, field string, value string, description string) {
+		if value == name {
+			refs = append(refs, FirewallAliasReference{Type: t, Index: index, Field: field, Description: description})
+		}
+	}
+
+	for i, rule := range resp.FilterRules {
+		add(FirewallAliasReferenceTypeFilterRule, i, "source address", rule.Source.Address, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "source port", rule.Source.Port, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "destination address", rule.Destination.Address, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "destination port", rule.Destination.Port, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "queue", rule.Queue, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "ACK queue", rule.AckQueue, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "in pipe", rule.InPipe, rule.Description)
+		add(FirewallAliasReferenceTypeFilterRule, i, "out pipe", rule.OutPipe, rule.Description)
+	}
+
+	for i, rule := range resp.NATPortForwards {
+		add(FirewallAliasReferenceTypeNATPortForward, i, "source address", rule.Source.Address, rule.Description)
+		add(FirewallAliasReferenceTypeNATPortForward, i, "source port", rule.Source.Port, rule.Description)
+		add(FirewallAliasReferenceTypeNATPortForward, i, "destination address", rule.Destination.Address, rule.Description)
+		add(FirewallAliasReferenceTypeNATPortForward, i, "destination port", rule.Destination.Port, rule.Description)
+		add(FirewallAliasReferenceTypeNATPortForward, i, "redirect target", rule.Target, rule.Description)
+		add(FirewallAliasReferenceTypeNATPortForward, i, "redirect target port", rule.LocalPort, rule.Description)
+	}
+
+	for i, rule := range resp.NATOutbound {
+		add(FirewallAliasReferenceTypeNATOutbound, i, "source", rule.Source.Network, rule.Description)
+		add(FirewallAliasReferenceTypeNATOutbound, i, "source port", rule.SourcePort, rule.Description)
+		add(FirewallAliasReferenceTypeNATOutbound, i, "destination", rule.Destination.Address, rule.Description)
+		add(FirewallAliasReferenceTypeNATOutbound, i, "destination port", rule.DstPort, rule.Description)
+		add(FirewallAliasReferenceTypeNATOutbound, i, "translation address", rule.Target, rule.Description)
+	}
+
+	for i, rule := range resp.NATOneToOne {
+		add(FirewallAliasReferenceTypeNATOneToOne, i, "source", rule.Source.Address, rule.Description)
+		add(FirewallAliasReferenceTypeNATOneToOne, i, "destination", rule.Destination.Address, rule.Description)
+	}
+
+	for i, alias := range resp.Aliases {
+		for _, addr := range strings.Split(alias.Addresses, " ") {
+			add(FirewallAliasReferenceTypeAlias, i, fmt.Sprintf("entry of '%s'", alias.Name), addr, alias.Description)
+		}
+	}
+
+	for i, route := range resp.StaticRoutes {
+		add(FirewallAliasReferenceTypeStaticRoute, i, "destination network", route.Network, route.Description)
+	}
+
+	for _, setting := range resp.Shaper {
+		add(FirewallAliasReferenceTypeShaper, setting.Index, setting.Field, setting.Value, setting.Name)
+	}
+
+	for _, setting := range resp.Limiters {
+		add(FirewallAliasReferenceTypeLimiter, setting.Index, setting.Field, setting.Value, setting.Name)
+	}
+
+	return refs
+}
+
+func (pf *Client) getFirewallAliasReferences(ctx context.Context, name string) (*FirewallAliasReferences, error) {
+	// shaper and limiter queues are nested, address and port settings are flattened to their path within the top level queue.
+	command := "$settings = function($queues) {" +
+		"$output = array(); $keys = array('address', 'network', 'port', 'sourceport', 'dstport');" +
+		"$walk = function($index, $name, $field, $key, $value) use (&$walk, &$output, $keys) {" +
+		"if (is_array($value)) { foreach ($value as $k => $v) { $walk($index, $name, $field === '' ? strval($k) : $field . '.' . $k, strval($k), $v); }; }" +
+		"elseif (is_string($value) && in_array($key, $keys, true)) { array_push($output, array('index' => $index, 'name' => $name, 'field' => $field, 'value' => $value)); };" +
+		"};" +
+		"foreach (is_array($queues) ? $queues : array() as $i => $queue) { $walk($i, is_array($queue) ? strval($queue['name']) : '', '', '', $queue); };" +
+		"return $output;" +
+		"};" +
+		"$list = function($v) { return is_array($v) ? $v : array(); };" +
+		"$output = array(" +
+		"'filter' => $list($config['filter']['rule'])," +
+		"'nat' => $list($config['nat']['rule'])," +
+		"'outbound' => $list($config['nat']['outbound']['rule'])," +
+		"'onetoone' => $list($config['nat']['onetoone'])," +
+		"'aliases' => $list($config['aliases']['alias'])," +
+		"'staticroutes' => $list($config['staticroutes']['route'])," +
+		"'shaper' => $settings($config['shaper']['queue'])," +
+		"'dnshaper' => $settings($config['dnshaper']['queue'])" +
+		");" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp aliasReferencesResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	refs := resp.references(name)
+
+	return &refs, nil
+}
+
+func (pf *Client) GetFirewallAliasReferences(ctx context.Context, name string) (*FirewallAliasReferences, error) {
+	pf.mutexes.FirewallAlias.Lock()
+	defer pf.mutexes.FirewallAlias.Unlock()
+
+	refs, err := pf.getFirewallAliasReferences(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall alias references (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return refs, nil
+}
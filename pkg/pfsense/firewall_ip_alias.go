@@ -5,11 +5,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+const (
+	FirewallIPAliasTypeHost    = "host"
+	FirewallIPAliasTypeNetwork = "network"
+)
+
+type FirewallIPAliasEntryType string
+
+const (
+	FirewallIPAliasEntryTypeIP      FirewallIPAliasEntryType = "ip"
+	FirewallIPAliasEntryTypeCIDR    FirewallIPAliasEntryType = "cidr"
+	FirewallIPAliasEntryTypeIPRange FirewallIPAliasEntryType = "range"
+	FirewallIPAliasEntryTypeFQDN    FirewallIPAliasEntryType = "fqdn"
+	FirewallIPAliasEntryTypeAlias   FirewallIPAliasEntryType = "alias"
+)
+
+var (
+	aliasNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]{1,31}$`)
+	numericRegex   = regexp.MustCompile(`^[0-9]+$`)
+	hostnameRegex  = regexp.MustCompile(`^([a-zA-Z0-9_]([-a-zA-Z0-9_]{0,61}[a-zA-Z0-9_])?)(\.[a-zA-Z0-9_]([-a-zA-Z0-9_]{0,61}[a-zA-Z0-9_])?)*\.?$`)
+)
+
 type firewallIPAliasResponse struct {
 	Name        string `json:"name"`
 	Description string `json:"descr"`
@@ -28,10 +51,70 @@ type FirewallIPAlias struct {
 }
 
 type FirewallIPAliasEntry struct {
-	Address     string // TODO can be a IP, CIDR, FQDN, or alias, how to validate?
+	Address     string
+	Type        FirewallIPAliasEntryType
 	Description string
 }
 
+// entries saved through the GUI are not held to the checks made on requests.
+func classifyFirewallIPAliasEntryAddress(addr string) FirewallIPAliasEntryType {
+	if _, err := netip.ParseAddr(addr); err == nil {
+		return FirewallIPAliasEntryTypeIP
+	}
+
+	if strings.Contains(addr, "/") {
+		return FirewallIPAliasEntryTypeCIDR
+	}
+
+	if before, after, found := strings.Cut(addr, "-"); found {
+		_, startErr := netip.ParseAddr(before)
+		_, endErr := netip.ParseAddr(after)
+
+		if startErr == nil && endErr == nil {
+			return FirewallIPAliasEntryTypeIPRange
+		}
+	}
+
+	if aliasNameRegex.MatchString(addr) && !numericRegex.MatchString(addr) {
+		return FirewallIPAliasEntryTypeAlias
+	}
+
+	return FirewallIPAliasEntryTypeFQDN
+}
+
+func parseFirewallIPAliasEntryAddress(addr string) (FirewallIPAliasEntryType, error) {
+	t := classifyFirewallIPAliasEntryAddress(addr)
+
+	switch t {
+	case FirewallIPAliasEntryTypeCIDR:
+		prefix, err := netip.ParsePrefix(addr)
+		if err != nil {
+			return "", fmt.Errorf("%w, invalid CIDR '%s'", ErrClientValidation, addr)
+		}
+
+		if prefix.Masked() != prefix {
+			return "", fmt.Errorf("%w, CIDR '%s' has host bits set, use '%s'", ErrClientValidation, addr, prefix.Masked())
+		}
+	case FirewallIPAliasEntryTypeIPRange:
+		before, after, _ := strings.Cut(addr, "-")
+		start, end := netip.MustParseAddr(before), netip.MustParseAddr(after)
+
+		if start.Is4() != end.Is4() {
+			return "", fmt.Errorf("%w, IP range '%s' mixes IPv4 and IPv6 addresses", ErrClientValidation, addr)
+		}
+
+		if end.Less(start) {
+			return "", fmt.Errorf("%w, IP range '%s' ends before it starts", ErrClientValidation, addr)
+		}
+	case FirewallIPAliasEntryTypeFQDN:
+		if len(addr) > 253 || !hostnameRegex.MatchString(addr) {
+			return "", fmt.Errorf("%w, address '%s' must be an IP address, CIDR, IP range, FQDN, or alias name", ErrClientValidation, addr)
+		}
+	}
+
+	return t, nil
+}
+
 func (ipAlias *FirewallIPAlias) SetName(name string) error {
 	ipAlias.Name = name
 
@@ -45,17 +128,54 @@ func (ipAlias *FirewallIPAlias) SetDescription(description string) error {
 }
 
 func (ipAlias *FirewallIPAlias) SetType(t string) error {
+	if t != FirewallIPAliasTypeHost && t != FirewallIPAliasTypeNetwork {
+		return fmt.Errorf("%w, type must be '%s' or '%s'", ErrClientValidation, FirewallIPAliasTypeHost, FirewallIPAliasTypeNetwork)
+	}
+
 	ipAlias.Type = t
 
 	return nil
 }
 
+// ValidateEntry enforces the entry rules pfSense applies for the alias type.
+func (ipAlias FirewallIPAlias) ValidateEntry(entry FirewallIPAliasEntry) error {
+	if entry.Type == FirewallIPAliasEntryTypeAlias && entry.Address == ipAlias.Name {
+		return fmt.Errorf("%w, alias '%s' cannot reference itself", ErrClientValidation, ipAlias.Name)
+	}
+
+	if ipAlias.Type != FirewallIPAliasTypeHost || entry.Type != FirewallIPAliasEntryTypeCIDR {
+		return nil
+	}
+
+	prefix, err := netip.ParsePrefix(entry.Address)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	if !prefix.IsSingleIP() {
+		return fmt.Errorf("%w, '%s' is a network, host aliases only accept IP addresses, IP ranges, FQDNs, or aliases", ErrClientValidation, entry.Address)
+	}
+
+	return nil
+}
+
 func (entry *FirewallIPAliasEntry) SetAddress(addr string) error {
+	t, err := parseFirewallIPAliasEntryAddress(addr)
+	if err != nil {
+		return err
+	}
+
 	entry.Address = addr
+	entry.Type = t
 
 	return nil
 }
 
+func (entry *FirewallIPAliasEntry) setAddressFromConfig(addr string) {
+	entry.Address = addr
+	entry.Type = classifyFirewallIPAliasEntryAddress(addr)
+}
+
 func (entry *FirewallIPAliasEntry) SetDescription(description string) error {
 	entry.Description = description
 
@@ -82,6 +202,67 @@ func (ipAliases FirewallIPAliases) GetControlIDByName(name string) (*int, error)
 	return nil, fmt.Errorf("firewall IP alias %w with name '%s'", ErrNotFound, name)
 }
 
+// ValidateEntryReference checks that an alias entry refers to an existing alias which can be nested in the given alias.
+func (ipAliases FirewallIPAliases) ValidateEntryReference(ipAlias FirewallIPAlias, entry FirewallIPAliasEntry) error {
+	if entry.Type != FirewallIPAliasEntryTypeAlias {
+		return nil
+	}
+
+	nested, err := ipAliases.GetByName(entry.Address)
+	if err != nil {
+		return err
+	}
+
+	if ipAlias.Type == FirewallIPAliasTypeHost && nested.Type != FirewallIPAliasTypeHost {
+		return fmt.Errorf("%w, host alias cannot contain %s alias '%s'", ErrClientValidation, nested.Type, nested.Name)
+	}
+
+	return nil
+}
+
+func (ipAlias FirewallIPAlias) nestedAliasNames() []string {
+	var names []string
+	for _, entry := range ipAlias.Entries {
+		if entry.Type == FirewallIPAliasEntryTypeAlias {
+			names = append(names, entry.Address)
+		}
+	}
+	return names
+}
+
+// NestedCycle returns the chain of alias names which lead back to the given alias through nested alias entries, if any.
+func (ipAliases FirewallIPAliases) NestedCycle(ipAlias FirewallIPAlias) []string {
+	nested := map[string][]string{}
+	for _, a := range ipAliases {
+		if a.Name != ipAlias.Name {
+			nested[a.Name] = a.nestedAliasNames()
+		}
+	}
+	nested[ipAlias.Name] = ipAlias.nestedAliasNames()
+
+	visited := map[string]bool{}
+	var walk func(chain []string) []string
+	walk = func(chain []string) []string {
+		for _, next := range nested[chain[len(chain)-1]] {
+			if next == ipAlias.Name {
+				return append(chain, next)
+			}
+
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+
+			if cycle := walk(append(chain, next)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+
+	return walk([]string{ipAlias.Name})
+}
+
 func (pf *Client) getFirewallIPAliases(ctx context.Context) (*FirewallIPAliases, error) {
 	command := "$output = array();" +
 		"array_walk($config['aliases']['alias'], function(&$v, $k) use (&$output) {" +
@@ -139,10 +320,7 @@ func (pf *Client) getFirewallIPAliases(ctx context.Context) (*FirewallIPAliases,
 			var entry FirewallIPAliasEntry
 			var err error
 
-			err = entry.SetAddress(addresses[i])
-			if err != nil {
-				return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
-			}
+			entry.setAddressFromConfig(addresses[i])
 
 			err = entry.SetDescription(details[i])
 			if err != nil {
@@ -183,6 +361,59 @@ func (pf *Client) GetFirewallIPAlias(ctx context.Context, name string) (*Firewal
 }
 
 func (pf *Client) createOrUpdateFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias, controlID *int) (*FirewallIPAlias, error) {
+	ipAliases, err := pf.getFirewallIPAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cycle := ipAliases.NestedCycle(ipAliasReq); cycle != nil {
+		return nil, fmt.Errorf("%w, nested alias cycle '%s'", ErrClientValidation, strings.Join(cycle, " -> "))
+	}
+
+	for _, entry := range ipAliasReq.Entries {
+		err = ipAliasReq.ValidateEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ipAliases.ValidateEntryReference(ipAliasReq, entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(ipAliasReq.Entries) > firewallIPAliasFormEntryLimit && controlID == nil:
+		err = pf.importFirewallIPAlias(ctx, ipAliasReq)
+	case len(ipAliasReq.Entries) > firewallIPAliasFormEntryLimit:
+		err = fmt.Errorf("firewall IP alias %w with control ID '%d'", ErrNotFound, *controlID)
+		for _, existing := range *ipAliases {
+			if existing.controlID == *controlID {
+				err = pf.writeFirewallIPAlias(ctx, ipAliasReq, existing)
+			}
+		}
+	default:
+		err = pf.postFirewallIPAlias(ctx, ipAliasReq, controlID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ipAliases, err = pf.getFirewallIPAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAlias, err := ipAliases.GetByName(ipAliasReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipAlias, nil
+}
+
+func (pf *Client) postFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias, controlID *int) error {
 	u := url.URL{Path: "firewall_aliases_edit.php"}
 	v := url.Values{
 		"name":  {ipAliasReq.Name},
@@ -204,25 +435,10 @@ func (pf *Client) createOrUpdateFirewallIPAlias(ctx context.Context, ipAliasReq
 
 	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return nil, err
-	}
-
-	err = scrapeHTMLValidationErrors(doc)
-	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ipAliases, err := pf.getFirewallIPAliases(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	ipAlias, err := ipAliases.GetByName(ipAliasReq.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	return ipAlias, nil
+	return scrapeHTMLValidationErrors(doc)
 }
 
 func (pf *Client) CreateFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias) (*FirewallIPAlias, error) {
@@ -273,16 +489,39 @@ func (pf *Client) DeleteFirewallIPAlias(ctx context.Context, name string) error
 		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, err)
 	}
 
+	refs, err := pf.getFirewallAliasReferences(ctx, name)
+	if err != nil {
+		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if len(*refs) > 0 {
+		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, &AliasInUseError{Name: name, References: *refs})
+	}
+
 	u := url.URL{Path: "firewall_aliases.php"}
 	v := url.Values{
 		"act": {"del"},
 		"id":  {strconv.Itoa(*controlID)},
 	}
 
-	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
 	if err != nil {
 		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, err)
 	}
 
+	ipAliases, err = pf.getFirewallIPAliases(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall IP alias, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err = ipAliases.GetByName(name); err == nil {
+		return fmt.Errorf("%w firewall IP alias, '%s' still exists", ErrDeleteOperationFailed, name)
+	}
+
 	return nil
 }
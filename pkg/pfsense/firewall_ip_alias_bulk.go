@@ -0,0 +1,220 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	FirewallIPAliasListFormatPlaintext = "plaintext"
+	FirewallIPAliasListFormatCSV       = "csv"
+)
+
+var FirewallIPAliasListFormats = []string{FirewallIPAliasListFormatPlaintext, FirewallIPAliasListFormatCSV}
+
+// firewallIPAliasFormEntryLimit keeps the edit form below the default PHP max_input_vars (1000), each entry posts two fields.
+const firewallIPAliasFormEntryLimit = 400
+
+func ValidateFirewallIPAliasListFormat(format string) error {
+	return validateChoice("list format", format, FirewallIPAliasListFormats)
+}
+
+// ParseFirewallIPAliasList parses an address list, one entry per line with an optional description. Plaintext lines are the
+// format accepted by firewall_aliases_import.php, the address followed by whitespace and the description. CSV lines are the address
+// and description columns. Blank lines and lines starting with '#' are ignored.
+func ParseFirewallIPAliasList(content string, format string) ([]FirewallIPAliasEntry, error) {
+	err := ValidateFirewallIPAliasListFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []FirewallIPAliasEntry
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var address, description string
+
+		switch format {
+		case FirewallIPAliasListFormatPlaintext:
+			fields := strings.Fields(line)
+			address = fields[0]
+			description = strings.Join(fields[1:], " ")
+		case FirewallIPAliasListFormatCSV:
+			r := csv.NewReader(strings.NewReader(line))
+			r.FieldsPerRecord = -1
+			r.TrimLeadingSpace = true
+
+			record, err := r.Read()
+			if err != nil {
+				return nil, fmt.Errorf("%w, line %d, %w", ErrClientValidation, i+1, err)
+			}
+
+			if len(record) > 2 {
+				return nil, fmt.Errorf("%w, line %d has %d columns, expected address and description", ErrClientValidation, i+1, len(record))
+			}
+
+			address = strings.TrimSpace(record[0])
+			if len(record) == 2 {
+				description = strings.TrimSpace(record[1])
+			}
+		}
+
+		var entry FirewallIPAliasEntry
+
+		err = entry.SetAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("line %d, %w", i+1, err)
+		}
+
+		err = entry.SetDescription(description)
+		if err != nil {
+			return nil, fmt.Errorf("line %d, %w", i+1, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// FormatFirewallIPAliasList renders entries in the given list format, the inverse of ParseFirewallIPAliasList.
+func FormatFirewallIPAliasList(entries []FirewallIPAliasEntry, format string) (string, error) {
+	err := ValidateFirewallIPAliasListFormat(format)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	switch format {
+	case FirewallIPAliasListFormatPlaintext:
+		for _, entry := range entries {
+			b.WriteString(strings.TrimSpace(fmt.Sprintf("%s %s", entry.Address, entry.Description)))
+			b.WriteString("\n")
+		}
+	case FirewallIPAliasListFormatCSV:
+		w := csv.NewWriter(&b)
+		for _, entry := range entries {
+			record := []string{entry.Address}
+			if entry.Description != "" {
+				record = append(record, entry.Description)
+			}
+
+			err = w.Write(record)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		w.Flush()
+		if err = w.Error(); err != nil {
+			return "", err
+		}
+	}
+
+	return b.String(), nil
+}
+
+func (ipAlias FirewallIPAlias) validateBulkEntries() error {
+	for _, entry := range ipAlias.Entries {
+		if strings.Contains(entry.Description, "||") {
+			return fmt.Errorf("%w, entry '%s' description cannot contain '||'", ErrClientValidation, entry.Address)
+		}
+	}
+
+	return nil
+}
+
+// the import page only accepts addresses, CIDRs, and ranges.
+func (pf *Client) importFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias) error {
+	err := ipAliasReq.validateBulkEntries()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range ipAliasReq.Entries {
+		if entry.Type == FirewallIPAliasEntryTypeFQDN || entry.Type == FirewallIPAliasEntryTypeAlias {
+			return fmt.Errorf("%w, entry '%s' must be an IP address, CIDR, or range when the alias has more than %d entries", ErrClientValidation, entry.Address, firewallIPAliasFormEntryLimit)
+		}
+	}
+
+	content, err := FormatFirewallIPAliasList(ipAliasReq.Entries, FirewallIPAliasListFormatPlaintext)
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{Path: "firewall_aliases_import.php"}
+	v := url.Values{
+		"name":        {ipAliasReq.Name},
+		"descr":       {ipAliasReq.Description},
+		"type":        {ipAliasReq.Type},
+		"aliasimport": {content},
+		"submit":      {"Save"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+// the import page cannot update aliases and only the edit page rewrites references, so renames are refused.
+func (pf *Client) writeFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias, existing FirewallIPAlias) error {
+	if ipAliasReq.Name != existing.Name {
+		return fmt.Errorf("%w, alias '%s' cannot be renamed while it has more than %d entries", ErrClientValidation, existing.Name, firewallIPAliasFormEntryLimit)
+	}
+
+	err := ipAliasReq.validateBulkEntries()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]string, 0, len(ipAliasReq.Entries))
+	details := make([]string, 0, len(ipAliasReq.Entries))
+	for _, entry := range ipAliasReq.Entries {
+		addresses = append(addresses, entry.Address)
+		details = append(details, entry.Description)
+	}
+
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+
+	command := fmt.Sprintf("$a = &$config['aliases']['alias'][%d];", existing.controlID) +
+		fmt.Sprintf("if ($a['name'] !== base64_decode('%s')) { print_r(json_encode(false)); } else {", encode(ipAliasReq.Name)) +
+		fmt.Sprintf("$a['descr'] = base64_decode('%s');", encode(ipAliasReq.Description)) +
+		fmt.Sprintf("$a['address'] = base64_decode('%s');", encode(strings.Join(addresses, " "))) +
+		fmt.Sprintf("$a['detail'] = base64_decode('%s');", encode(strings.Join(details, "||"))) +
+		"write_config('Firewall alias updated');" +
+		"mark_subsystem_dirty('aliases');" +
+		"print_r(json_encode(true));" +
+		"};"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return err
+	}
+
+	var written bool
+	err = json.Unmarshal(b, &written)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	if !written {
+		return fmt.Errorf("alias '%s' moved while being updated", ipAliasReq.Name)
+	}
+
+	return nil
+}
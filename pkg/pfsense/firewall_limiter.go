@@ -0,0 +1,1140 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	FirewallLimiterMaskNone        = "none"
+	FirewallLimiterMaskSource      = "source"
+	FirewallLimiterMaskDestination = "destination"
+
+	FirewallLimiterSchedulerDefault = "wf2q+"
+	FirewallLimiterSchedulerFQCoDel = "fq_codel"
+
+	FirewallLimiterQueueManagementDefault = "droptail"
+
+	FirewallLimiterMaxBandwidths = 10
+)
+
+const (
+	firewallLimiterEnabled = "on"
+	firewallLimiterNoSched = "none"
+)
+
+var (
+	limiterNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+)
+
+var FirewallLimiterBandwidthUnits = []string{"b", "Kb", "Mb", "Gb"}
+
+var FirewallLimiterMasks = []string{FirewallLimiterMaskNone, FirewallLimiterMaskSource, FirewallLimiterMaskDestination}
+
+var FirewallLimiterSchedulers = []string{FirewallLimiterSchedulerDefault, "fifo", "qfq", "rr", "prio", FirewallLimiterSchedulerFQCoDel, "fq_pie"}
+
+var FirewallLimiterQueueManagements = []string{FirewallLimiterQueueManagementDefault, "codel", "pie", "red", "gred"}
+
+var firewallLimiterMaskValues = map[string]string{
+	FirewallLimiterMaskNone:        "none",
+	FirewallLimiterMaskSource:      "srcaddress",
+	FirewallLimiterMaskDestination: "dstaddress",
+}
+
+// DefaultFirewallLimiterFQCoDel matches the FQ_CoDel parameter defaults of dummynet.
+var DefaultFirewallLimiterFQCoDel = FirewallLimiterFQCoDel{
+	Target:   5,
+	Interval: 100,
+	Quantum:  1514,
+	Limit:    10240,
+	Flows:    1024,
+}
+
+type firewallLimiterBandwidthResponse struct {
+	Bandwidth string `json:"bw"`
+	Unit      string `json:"bwscale"`
+	Schedule  string `json:"bwsched"`
+}
+
+type firewallLimiterQueueResponse struct {
+	Name            string `json:"name"`
+	Number          string `json:"number"`
+	Enabled         string `json:"enabled"`
+	Description     string `json:"description"`
+	Mask            string `json:"mask"`
+	MaskBits        string `json:"maskbits"`
+	MaskBitsV6      string `json:"maskbitsv6"`
+	Weight          string `json:"weight"`
+	QueueLength     string `json:"qlimit"`
+	QueueManagement string `json:"aqm"`
+	ECN             string `json:"ecn"`
+}
+
+type firewallLimiterResponse struct {
+	Name        string `json:"name"`
+	Number      string `json:"number"`
+	Enabled     string `json:"enabled"`
+	Description string `json:"description"`
+	Bandwidth   struct {
+		Items []firewallLimiterBandwidthResponse `json:"item"`
+	} `json:"bandwidth"`
+	Mask            string                         `json:"mask"`
+	MaskBits        string                         `json:"maskbits"`
+	MaskBitsV6      string                         `json:"maskbitsv6"`
+	QueueLength     string                         `json:"qlimit"`
+	Delay           string                         `json:"delay"`
+	Scheduler       string                         `json:"sched"`
+	QueueManagement string                         `json:"aqm"`
+	ECN             string                         `json:"ecn"`
+	FQCoDelTarget   string                         `json:"param_fq_codel_target"`
+	FQCoDelInterval string                         `json:"param_fq_codel_interval"`
+	FQCoDelQuantum  string                         `json:"param_fq_codel_quantum"`
+	FQCoDelLimit    string                         `json:"param_fq_codel_limit"`
+	FQCoDelFlows    string                         `json:"param_fq_codel_flows"`
+	Queues          []firewallLimiterQueueResponse `json:"queue"`
+}
+
+type FirewallLimiterBandwidth struct {
+	Bandwidth int
+	Unit      string
+	Schedule  string
+}
+
+type FirewallLimiterMask struct {
+	Type     string
+	IPv4Bits *int
+	IPv6Bits *int
+}
+
+type FirewallLimiterFQCoDel struct {
+	Target   int
+	Interval int
+	Quantum  int
+	Limit    int
+	Flows    int
+}
+
+type FirewallLimiter struct {
+	Name            string
+	Description     string
+	Enabled         bool
+	Bandwidths      []FirewallLimiterBandwidth
+	Mask            FirewallLimiterMask
+	QueueLength     *int
+	Delay           *int
+	Scheduler       string
+	FQCoDel         *FirewallLimiterFQCoDel
+	QueueManagement string
+	ECN             bool
+	Number          int
+	Queues          []FirewallLimiterQueue
+}
+
+type FirewallLimiterQueue struct {
+	Name            string
+	Limiter         string
+	Description     string
+	Enabled         bool
+	Mask            FirewallLimiterMask
+	Weight          *int
+	QueueLength     *int
+	QueueManagement string
+	ECN             bool
+	Number          int
+}
+
+func validateFirewallLimiterName(name string) error {
+	if !limiterNameRegex.MatchString(name) {
+		return fmt.Errorf("%w, name must be 1-32 characters and only contain letters, numbers, underscores, and hyphens", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (bandwidth *FirewallLimiterBandwidth) SetBandwidth(b int) error {
+	if b < 1 {
+		return fmt.Errorf("%w, bandwidth must be greater than zero", ErrClientValidation)
+	}
+
+	bandwidth.Bandwidth = b
+
+	return nil
+}
+
+func (bandwidth *FirewallLimiterBandwidth) SetUnit(unit string) error {
+	err := validateChoice("bandwidth unit", unit, FirewallLimiterBandwidthUnits)
+	if err != nil {
+		return err
+	}
+
+	bandwidth.Unit = unit
+
+	return nil
+}
+
+func (bandwidth *FirewallLimiterBandwidth) SetSchedule(schedule string) error {
+	if schedule != "" && !scheduleNameRegex.MatchString(schedule) {
+		return fmt.Errorf("%w, schedule '%s' is not a valid schedule name", ErrClientValidation, schedule)
+	}
+
+	bandwidth.Schedule = schedule
+
+	return nil
+}
+
+func (mask *FirewallLimiterMask) SetType(t string) error {
+	err := validateChoice("mask", t, FirewallLimiterMasks)
+	if err != nil {
+		return err
+	}
+
+	mask.Type = t
+
+	return nil
+}
+
+func (mask *FirewallLimiterMask) SetIPv4Bits(bits int) error {
+	if bits < 1 || bits > 32 {
+		return fmt.Errorf("%w, IPv4 mask bits must be between 1 and 32", ErrClientValidation)
+	}
+
+	mask.IPv4Bits = &bits
+
+	return nil
+}
+
+func (mask *FirewallLimiterMask) SetIPv6Bits(bits int) error {
+	if bits < 1 || bits > 128 {
+		return fmt.Errorf("%w, IPv6 mask bits must be between 1 and 128", ErrClientValidation)
+	}
+
+	mask.IPv6Bits = &bits
+
+	return nil
+}
+
+func (mask FirewallLimiterMask) Validate() error {
+	if mask.Type == FirewallLimiterMaskNone && (mask.IPv4Bits != nil || mask.IPv6Bits != nil) {
+		return fmt.Errorf("%w, mask bits require a source or destination mask", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (mask FirewallLimiterMask) setValues(v url.Values) {
+	v.Set("mask", firewallLimiterMaskValues[mask.Type])
+	v.Set("maskbits", formatOptionalInt(mask.IPv4Bits))
+	v.Set("maskbitsv6", formatOptionalInt(mask.IPv6Bits))
+}
+
+func parseFirewallLimiterMask(maskType string, bits string, bitsV6 string) (*FirewallLimiterMask, error) {
+	var mask FirewallLimiterMask
+
+	t := FirewallLimiterMaskNone
+	for k, v := range firewallLimiterMaskValues {
+		if v == maskType {
+			t = k
+		}
+	}
+
+	err := mask.SetType(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// bits are only meaningful (and only kept) when a mask is selected
+	if t == FirewallLimiterMaskNone {
+		return &mask, nil
+	}
+
+	ipv4Bits, err := parseOptionalInt("mask bits", bits)
+	if err != nil {
+		return nil, err
+	}
+
+	if ipv4Bits != nil {
+		err = mask.SetIPv4Bits(*ipv4Bits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ipv6Bits, err := parseOptionalInt("IPv6 mask bits", bitsV6)
+	if err != nil {
+		return nil, err
+	}
+
+	if ipv6Bits != nil {
+		err = mask.SetIPv6Bits(*ipv6Bits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &mask, nil
+}
+
+func (fqCoDel FirewallLimiterFQCoDel) Validate() error {
+	for name, value := range map[string]int{
+		"target":   fqCoDel.Target,
+		"interval": fqCoDel.Interval,
+		"quantum":  fqCoDel.Quantum,
+		"limit":    fqCoDel.Limit,
+		"flows":    fqCoDel.Flows,
+	} {
+		if value < 1 {
+			return fmt.Errorf("%w, FQ_CoDel %s must be greater than zero", ErrClientValidation, name)
+		}
+	}
+
+	if fqCoDel.Flows > 65536 {
+		return fmt.Errorf("%w, FQ_CoDel flows must not exceed 65536", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetName(name string) error {
+	err := validateFirewallLimiterName(name)
+	if err != nil {
+		return err
+	}
+
+	limiter.Name = name
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetDescription(description string) error {
+	limiter.Description = description
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetEnabled(enabled bool) error {
+	limiter.Enabled = enabled
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetBandwidths(bandwidths []FirewallLimiterBandwidth) error {
+	if len(bandwidths) == 0 {
+		return fmt.Errorf("%w, at least one bandwidth is required", ErrClientValidation)
+	}
+
+	if len(bandwidths) > FirewallLimiterMaxBandwidths {
+		return fmt.Errorf("%w, limiter cannot have more than %d bandwidths", ErrClientValidation, FirewallLimiterMaxBandwidths)
+	}
+
+	seen := map[string]bool{}
+	for _, bandwidth := range bandwidths {
+		if seen[bandwidth.Schedule] {
+			if bandwidth.Schedule == "" {
+				return fmt.Errorf("%w, only one bandwidth may omit a schedule", ErrClientValidation)
+			}
+
+			return fmt.Errorf("%w, schedule '%s' used by more than one bandwidth", ErrClientValidation, bandwidth.Schedule)
+		}
+
+		seen[bandwidth.Schedule] = true
+	}
+
+	limiter.Bandwidths = bandwidths
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetMask(mask FirewallLimiterMask) error {
+	err := mask.Validate()
+	if err != nil {
+		return err
+	}
+
+	limiter.Mask = mask
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetQueueLength(length int) error {
+	if length < 1 {
+		return fmt.Errorf("%w, queue length must be greater than zero", ErrClientValidation)
+	}
+
+	limiter.QueueLength = &length
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetDelay(delay int) error {
+	if delay < 0 || delay > 10000 {
+		return fmt.Errorf("%w, delay must be between 0 and 10000 milliseconds", ErrClientValidation)
+	}
+
+	limiter.Delay = &delay
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetScheduler(scheduler string) error {
+	err := validateChoice("scheduler", scheduler, FirewallLimiterSchedulers)
+	if err != nil {
+		return err
+	}
+
+	limiter.Scheduler = scheduler
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetFQCoDel(fqCoDel FirewallLimiterFQCoDel) error {
+	err := fqCoDel.Validate()
+	if err != nil {
+		return err
+	}
+
+	limiter.FQCoDel = &fqCoDel
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetQueueManagement(queueManagement string) error {
+	err := validateChoice("queue management algorithm", queueManagement, FirewallLimiterQueueManagements)
+	if err != nil {
+		return err
+	}
+
+	limiter.QueueManagement = queueManagement
+
+	return nil
+}
+
+func (limiter *FirewallLimiter) SetECN(ecn bool) error {
+	limiter.ECN = ecn
+
+	return nil
+}
+
+// Validate checks the settings that depend on each other, FQ_CoDel parameters and ECN support.
+func (limiter FirewallLimiter) Validate() error {
+	if limiter.Scheduler == FirewallLimiterSchedulerFQCoDel && limiter.FQCoDel == nil {
+		return fmt.Errorf("%w, '%s' scheduler requires FQ_CoDel parameters", ErrClientValidation, FirewallLimiterSchedulerFQCoDel)
+	}
+
+	if limiter.Scheduler != FirewallLimiterSchedulerFQCoDel && limiter.FQCoDel != nil {
+		return fmt.Errorf("%w, FQ_CoDel parameters require the '%s' scheduler", ErrClientValidation, FirewallLimiterSchedulerFQCoDel)
+	}
+
+	if limiter.ECN && limiter.QueueManagement == FirewallLimiterQueueManagementDefault && !strings.HasPrefix(limiter.Scheduler, "fq_") {
+		return fmt.Errorf("%w, ECN requires an active queue management algorithm or a FQ_CoDel or FQ_PIE scheduler", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetName(name string) error {
+	err := validateFirewallLimiterName(name)
+	if err != nil {
+		return err
+	}
+
+	queue.Name = name
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetLimiter(limiter string) error {
+	err := validateFirewallLimiterName(limiter)
+	if err != nil {
+		return err
+	}
+
+	queue.Limiter = limiter
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetDescription(description string) error {
+	queue.Description = description
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetEnabled(enabled bool) error {
+	queue.Enabled = enabled
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetMask(mask FirewallLimiterMask) error {
+	err := mask.Validate()
+	if err != nil {
+		return err
+	}
+
+	queue.Mask = mask
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetWeight(weight int) error {
+	if weight < 1 || weight > 100 {
+		return fmt.Errorf("%w, weight must be between 1 and 100", ErrClientValidation)
+	}
+
+	queue.Weight = &weight
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetQueueLength(length int) error {
+	if length < 1 {
+		return fmt.Errorf("%w, queue length must be greater than zero", ErrClientValidation)
+	}
+
+	queue.QueueLength = &length
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetQueueManagement(queueManagement string) error {
+	err := validateChoice("queue management algorithm", queueManagement, FirewallLimiterQueueManagements)
+	if err != nil {
+		return err
+	}
+
+	queue.QueueManagement = queueManagement
+
+	return nil
+}
+
+func (queue *FirewallLimiterQueue) SetECN(ecn bool) error {
+	queue.ECN = ecn
+
+	return nil
+}
+
+func (queue FirewallLimiterQueue) Validate() error {
+	if queue.Name == queue.Limiter {
+		return fmt.Errorf("%w, queue cannot have the same name as its limiter", ErrClientValidation)
+	}
+
+	if queue.ECN && queue.QueueManagement == FirewallLimiterQueueManagementDefault {
+		return fmt.Errorf("%w, ECN requires an active queue management algorithm", ErrClientValidation)
+	}
+
+	return nil
+}
+
+type FirewallLimiters []FirewallLimiter
+
+func (limiters FirewallLimiters) GetByName(name string) (*FirewallLimiter, error) {
+	for _, l := range limiters {
+		if l.Name == name {
+			return &l, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall limiter %w with name '%s'", ErrNotFound, name)
+}
+
+// GetQueueByName searches the queues of every limiter, pfSense requires limiter and queue names to be unique.
+func (limiters FirewallLimiters) GetQueueByName(name string) (*FirewallLimiterQueue, error) {
+	for _, l := range limiters {
+		for _, q := range l.Queues {
+			if q.Name == name {
+				return &q, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("firewall limiter queue %w with name '%s'", ErrNotFound, name)
+}
+
+func parseFirewallLimiterQueue(limiter string, resp firewallLimiterQueueResponse) (*FirewallLimiterQueue, error) {
+	var queue FirewallLimiterQueue
+	var err error
+
+	queue.Enabled = resp.Enabled == firewallLimiterEnabled
+	queue.ECN = resp.ECN == firewallLimiterEnabled
+
+	queue.Number, err = strconv.Atoi(resp.Number)
+	if err != nil {
+		return nil, fmt.Errorf("%w limiter queue number '%s'", ErrUnableToParse, resp.Number)
+	}
+
+	err = queue.SetName(resp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queue.SetLimiter(limiter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queue.SetDescription(html.UnescapeString(resp.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	mask, err := parseFirewallLimiterMask(resp.Mask, resp.MaskBits, resp.MaskBitsV6)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queue.SetMask(*mask)
+	if err != nil {
+		return nil, err
+	}
+
+	weight, err := parseOptionalInt("queue weight", resp.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	if weight != nil {
+		err = queue.SetWeight(*weight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	queueLength, err := parseOptionalInt("queue length", resp.QueueLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if queueLength != nil {
+		err = queue.SetQueueLength(*queueLength)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.QueueManagement == "" {
+		resp.QueueManagement = FirewallLimiterQueueManagementDefault
+	}
+
+	err = queue.SetQueueManagement(resp.QueueManagement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queue, nil
+}
+
+func parseFirewallLimiter(resp firewallLimiterResponse) (*FirewallLimiter, error) {
+	var limiter FirewallLimiter
+	var err error
+
+	limiter.Enabled = resp.Enabled == firewallLimiterEnabled
+	limiter.ECN = resp.ECN == firewallLimiterEnabled
+
+	limiter.Number, err = strconv.Atoi(resp.Number)
+	if err != nil {
+		return nil, fmt.Errorf("%w limiter number '%s'", ErrUnableToParse, resp.Number)
+	}
+
+	err = limiter.SetName(resp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = limiter.SetDescription(html.UnescapeString(resp.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	var bandwidths []FirewallLimiterBandwidth
+	for _, bandwidthResp := range resp.Bandwidth.Items {
+		var bandwidth FirewallLimiterBandwidth
+
+		b, err := strconv.Atoi(bandwidthResp.Bandwidth)
+		if err != nil {
+			return nil, fmt.Errorf("%w limiter bandwidth '%s'", ErrUnableToParse, bandwidthResp.Bandwidth)
+		}
+
+		err = bandwidth.SetBandwidth(b)
+		if err != nil {
+			return nil, err
+		}
+
+		err = bandwidth.SetUnit(bandwidthResp.Unit)
+		if err != nil {
+			return nil, err
+		}
+
+		if bandwidthResp.Schedule != firewallLimiterNoSched {
+			err = bandwidth.SetSchedule(bandwidthResp.Schedule)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		bandwidths = append(bandwidths, bandwidth)
+	}
+
+	err = limiter.SetBandwidths(bandwidths)
+	if err != nil {
+		return nil, err
+	}
+
+	mask, err := parseFirewallLimiterMask(resp.Mask, resp.MaskBits, resp.MaskBitsV6)
+	if err != nil {
+		return nil, err
+	}
+
+	err = limiter.SetMask(*mask)
+	if err != nil {
+		return nil, err
+	}
+
+	queueLength, err := parseOptionalInt("queue length", resp.QueueLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if queueLength != nil {
+		err = limiter.SetQueueLength(*queueLength)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	delay, err := parseOptionalInt("delay", resp.Delay)
+	if err != nil {
+		return nil, err
+	}
+
+	if delay != nil {
+		err = limiter.SetDelay(*delay)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Scheduler == "" {
+		resp.Scheduler = FirewallLimiterSchedulerDefault
+	}
+
+	err = limiter.SetScheduler(resp.Scheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	if limiter.Scheduler == FirewallLimiterSchedulerFQCoDel {
+		fqCoDel := DefaultFirewallLimiterFQCoDel
+
+		for _, param := range []struct {
+			name  string
+			value string
+			field *int
+		}{
+			{"target", resp.FQCoDelTarget, &fqCoDel.Target},
+			{"interval", resp.FQCoDelInterval, &fqCoDel.Interval},
+			{"quantum", resp.FQCoDelQuantum, &fqCoDel.Quantum},
+			{"limit", resp.FQCoDelLimit, &fqCoDel.Limit},
+			{"flows", resp.FQCoDelFlows, &fqCoDel.Flows},
+		} {
+			// blank parameters fall back to the dummynet defaults
+			value, err := parseOptionalInt(fmt.Sprintf("FQ_CoDel %s", param.name), param.value)
+			if err != nil {
+				return nil, err
+			}
+
+			if value != nil {
+				*param.field = *value
+			}
+		}
+
+		err = limiter.SetFQCoDel(fqCoDel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.QueueManagement == "" {
+		resp.QueueManagement = FirewallLimiterQueueManagementDefault
+	}
+
+	err = limiter.SetQueueManagement(resp.QueueManagement)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queueResp := range resp.Queues {
+		queue, err := parseFirewallLimiterQueue(limiter.Name, queueResp)
+		if err != nil {
+			return nil, err
+		}
+
+		limiter.Queues = append(limiter.Queues, *queue)
+	}
+
+	return &limiter, nil
+}
+
+func (pf *Client) getFirewallLimiters(ctx context.Context) (*FirewallLimiters, error) {
+	b, err := pf.getConfigJSON(ctx, "['dnshaper']['queue']")
+	if err != nil {
+		return nil, err
+	}
+
+	var limiterResp []firewallLimiterResponse
+	err = json.Unmarshal(b, &limiterResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var limiters FirewallLimiters
+	for _, resp := range limiterResp {
+		limiter, err := parseFirewallLimiter(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w limiter response, %w", ErrUnableToParse, err)
+		}
+
+		limiters = append(limiters, *limiter)
+	}
+
+	return &limiters, nil
+}
+
+func (pf *Client) GetFirewallLimiters(ctx context.Context) (*FirewallLimiters, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiters, %w", ErrGetOperationFailed, err)
+	}
+
+	return limiters, nil
+}
+
+func (pf *Client) GetFirewallLimiter(ctx context.Context, name string) (*FirewallLimiter, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return limiters.GetByName(name)
+}
+
+func (pf *Client) postFirewallLimiterForm(ctx context.Context, v url.Values) error {
+	u := url.URL{Path: "firewall_shaper_vinterface.php"}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) createOrUpdateFirewallLimiter(ctx context.Context, limiterReq FirewallLimiter, create bool) (*FirewallLimiter, error) {
+	err := limiterReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{
+		"description": {limiterReq.Description},
+		"qlimit":      {formatOptionalInt(limiterReq.QueueLength)},
+		"delay":       {formatOptionalInt(limiterReq.Delay)},
+		"sched":       {limiterReq.Scheduler},
+		"aqm":         {limiterReq.QueueManagement},
+		"save":        {"Save"},
+	}
+
+	if create {
+		v.Set("newname", limiterReq.Name)
+	} else {
+		v.Set("name", limiterReq.Name)
+		v.Set("pipe", limiterReq.Name)
+	}
+
+	if limiterReq.Enabled {
+		v.Set("enabled", firewallLimiterEnabled)
+	}
+
+	if limiterReq.ECN {
+		v.Set("ecn", firewallLimiterEnabled)
+	}
+
+	for i, bandwidth := range limiterReq.Bandwidths {
+		schedule := bandwidth.Schedule
+		if schedule == "" {
+			schedule = firewallLimiterNoSched
+		}
+
+		v.Set(fmt.Sprintf("bandwidth%d", i), strconv.Itoa(bandwidth.Bandwidth))
+		v.Set(fmt.Sprintf("bwtype%d", i), bandwidth.Unit)
+		v.Set(fmt.Sprintf("bwsched%d", i), schedule)
+	}
+
+	limiterReq.Mask.setValues(v)
+
+	if limiterReq.FQCoDel != nil {
+		v.Set("param_fq_codel_target", strconv.Itoa(limiterReq.FQCoDel.Target))
+		v.Set("param_fq_codel_interval", strconv.Itoa(limiterReq.FQCoDel.Interval))
+		v.Set("param_fq_codel_quantum", strconv.Itoa(limiterReq.FQCoDel.Quantum))
+		v.Set("param_fq_codel_limit", strconv.Itoa(limiterReq.FQCoDel.Limit))
+		v.Set("param_fq_codel_flows", strconv.Itoa(limiterReq.FQCoDel.Flows))
+	}
+
+	err = pf.postFirewallLimiterForm(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter, err := limiters.GetByName(limiterReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return limiter, nil
+}
+
+func (pf *Client) CreateFirewallLimiter(ctx context.Context, limiterReq FirewallLimiter) (*FirewallLimiter, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := limiters.GetByName(limiterReq.Name); err == nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w, limiter '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, limiterReq.Name)
+	}
+
+	limiter, err := pf.createOrUpdateFirewallLimiter(ctx, limiterReq, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w", ErrCreateOperationFailed, err)
+	}
+
+	return limiter, nil
+}
+
+func (pf *Client) UpdateFirewallLimiter(ctx context.Context, limiterReq FirewallLimiter) (*FirewallLimiter, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if _, err := limiters.GetByName(limiterReq.Name); err != nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w", ErrUpdateOperationFailed, err)
+	}
+
+	limiter, err := pf.createOrUpdateFirewallLimiter(ctx, limiterReq, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return limiter, nil
+}
+
+func (pf *Client) DeleteFirewallLimiter(ctx context.Context, name string) error {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := limiters.GetByName(name); err != nil {
+		return fmt.Errorf("%w firewall limiter, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = pf.postFirewallLimiterForm(ctx, url.Values{
+		"action": {"delete"},
+		"pipe":   {name},
+	})
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter, %w", ErrDeleteOperationFailed, err)
+	}
+
+	limiters, err = pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete limiters referenced by filter rules
+	if _, err = limiters.GetByName(name); err == nil {
+		return fmt.Errorf("%w firewall limiter, '%s' still exists, it may be in use by a filter rule", ErrDeleteOperationFailed, name)
+	}
+
+	return nil
+}
+
+func (pf *Client) GetFirewallLimiterQueue(ctx context.Context, name string) (*FirewallLimiterQueue, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return limiters.GetQueueByName(name)
+}
+
+func (pf *Client) createOrUpdateFirewallLimiterQueue(ctx context.Context, queueReq FirewallLimiterQueue, create bool) (*FirewallLimiterQueue, error) {
+	err := queueReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{
+		"pipe":        {queueReq.Limiter},
+		"description": {queueReq.Description},
+		"weight":      {formatOptionalInt(queueReq.Weight)},
+		"qlimit":      {formatOptionalInt(queueReq.QueueLength)},
+		"aqm":         {queueReq.QueueManagement},
+		"save":        {"Save"},
+	}
+
+	if create {
+		v.Set("newname", queueReq.Name)
+		v.Set("parentqueue", queueReq.Limiter)
+	} else {
+		v.Set("name", queueReq.Name)
+	}
+
+	if queueReq.Enabled {
+		v.Set("enabled", firewallLimiterEnabled)
+	}
+
+	if queueReq.ECN {
+		v.Set("ecn", firewallLimiterEnabled)
+	}
+
+	queueReq.Mask.setValues(v)
+
+	err = pf.postFirewallLimiterForm(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := limiters.GetQueueByName(queueReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) CreateFirewallLimiterQueue(ctx context.Context, queueReq FirewallLimiterQueue) (*FirewallLimiterQueue, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := limiters.GetByName(queueReq.Limiter); err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := limiters.GetByName(queueReq.Name); err == nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w, a limiter named '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, queueReq.Name)
+	}
+
+	if _, err := limiters.GetQueueByName(queueReq.Name); err == nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w, queue '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, queueReq.Name)
+	}
+
+	queue, err := pf.createOrUpdateFirewallLimiterQueue(ctx, queueReq, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) UpdateFirewallLimiterQueue(ctx context.Context, queueReq FirewallLimiterQueue) (*FirewallLimiterQueue, error) {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := limiters.GetQueueByName(queueReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if existing.Limiter != queueReq.Limiter {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w, queue '%s' belongs to limiter '%s'", ErrUpdateOperationFailed, ErrClientValidation, queueReq.Name, existing.Limiter)
+	}
+
+	queue, err := pf.createOrUpdateFirewallLimiterQueue(ctx, queueReq, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall limiter queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) DeleteFirewallLimiterQueue(ctx context.Context, name string) error {
+	pf.mutexes.FirewallLimiter.Lock()
+	defer pf.mutexes.FirewallLimiter.Unlock()
+
+	limiters, err := pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	queue, err := limiters.GetQueueByName(name)
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = pf.postFirewallLimiterForm(ctx, url.Values{
+		"action": {"delete"},
+		"pipe":   {queue.Limiter},
+		"queue":  {queue.Name},
+	})
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	limiters, err = pf.getFirewallLimiters(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall limiter queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete queues referenced by filter rules
+	if _, err = limiters.GetQueueByName(name); err == nil {
+		return fmt.Errorf("%w firewall limiter queue, '%s' still exists, it may be in use by a filter rule", ErrDeleteOperationFailed, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,407 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FirewallLogActionPass   = "pass"
+	FirewallLogActionBlock  = "block"
+	FirewallLogActionReject = "reject"
+
+	DefaultFirewallLogLines = 1000
+	MaxFirewallLogLines     = 100000
+)
+
+var FirewallLogActions = []string{FirewallLogActionPass, FirewallLogActionBlock, FirewallLogActionReject}
+
+const (
+	firewallLogTag          = "filterlog"
+	firewallLogBSDTimestamp = "Jan _2 15:04:05"
+)
+
+type FirewallLogEntry struct {
+	Time               time.Time
+	RuleNumber         string
+	Tracker            string
+	Interface          string
+	Reason             string
+	Action             string
+	Direction          string
+	IPVersion          int
+	Protocol           string
+	Length             *int
+	SourceAddress      netip.Addr
+	DestinationAddress netip.Addr
+	SourcePort         *int
+	DestinationPort    *int
+	TCPFlags           string
+	ICMPType           string
+}
+
+type FirewallLogEntries []FirewallLogEntry
+
+// FirewallLog holds the entries matching a filter, Skipped has the parse error of each filterlog line which was skipped.
+type FirewallLog struct {
+	Entries FirewallLogEntries
+	Skipped []error
+}
+
+// FirewallLogFilter selects log entries, empty fields match any entry.
+type FirewallLogFilter struct {
+	Interface string
+	Action    string
+	Tracker   string
+	Since     *time.Time
+	Until     *time.Time
+	Lines     int
+}
+
+func (filter *FirewallLogFilter) SetInterface(iface string) error {
+	filter.Interface = iface
+
+	return nil
+}
+
+func (filter *FirewallLogFilter) SetAction(action string) error {
+	for _, a := range FirewallLogActions {
+		if action == a {
+			filter.Action = action
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, action must be one of '%s'", ErrClientValidation, strings.Join(FirewallLogActions, "', '"))
+}
+
+func (filter *FirewallLogFilter) SetTracker(tracker string) error {
+	if _, err := strconv.ParseUint(tracker, 10, 64); err != nil {
+		return fmt.Errorf("%w, tracker ID '%s' must be a number", ErrClientValidation, tracker)
+	}
+
+	filter.Tracker = tracker
+
+	return nil
+}
+
+func (filter *FirewallLogFilter) SetSince(since string) error {
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return fmt.Errorf("%w, since '%s' must be an RFC 3339 timestamp", ErrClientValidation, since)
+	}
+
+	filter.Since = &t
+
+	return nil
+}
+
+func (filter *FirewallLogFilter) SetUntil(until string) error {
+	t, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		return fmt.Errorf("%w, until '%s' must be an RFC 3339 timestamp", ErrClientValidation, until)
+	}
+
+	filter.Until = &t
+
+	return nil
+}
+
+func (filter *FirewallLogFilter) SetLines(lines int) error {
+	if lines < 1 || lines > MaxFirewallLogLines {
+		return fmt.Errorf("%w, lines must be between 1 and %d", ErrClientValidation, MaxFirewallLogLines)
+	}
+
+	filter.Lines = lines
+
+	return nil
+}
+
+func (filter FirewallLogFilter) Matches(entry FirewallLogEntry) bool {
+	if filter.Interface != "" && entry.Interface != filter.Interface {
+		return false
+	}
+
+	if filter.Action != "" && entry.Action != filter.Action {
+		return false
+	}
+
+	if filter.Tracker != "" && entry.Tracker != filter.Tracker {
+		return false
+	}
+
+	if filter.Since != nil && entry.Time.Before(*filter.Since) {
+		return false
+	}
+
+	if filter.Until != nil && entry.Time.After(*filter.Until) {
+		return false
+	}
+
+	return true
+}
+
+func (entries FirewallLogEntries) Filter(filter FirewallLogFilter) FirewallLogEntries {
+	var filtered FirewallLogEntries
+	for _, entry := range entries {
+		if filter.Matches(entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// ParseFirewallLog parses filterlog lines, skipping lines written by other programs. Filterlog lines which cannot be parsed (for
+// example truncated lines) are skipped as well, with one error returned for each. Timestamps without a year (BSD syslog format)
+// are placed in the year of now, or the year before if that would be in the future, and interpreted in the location of now.
+func ParseFirewallLog(lines []string, now time.Time) (FirewallLogEntries, []error) {
+	var entries FirewallLogEntries
+	var skipped []error
+	for _, line := range lines {
+		if !strings.Contains(line, firewallLogTag) {
+			continue
+		}
+
+		entry, err := ParseFirewallLogEntry(line, now)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, skipped
+}
+
+// ParseFirewallLogEntry parses a single syslog line containing a filterlog CSV record, in either BSD (RFC 3164) or RFC 5424 syslog format.
+func ParseFirewallLogEntry(line string, now time.Time) (*FirewallLogEntry, error) {
+	var entry FirewallLogEntry
+
+	timestamp, record, err := splitFirewallLogLine(line)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Time, err = parseFirewallLogTime(timestamp, now)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(strings.NewReader(record))
+	r.FieldsPerRecord = -1
+	fields, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w filter log record '%s', %w", ErrUnableToParse, record, err)
+	}
+
+	err = entry.setFields(fields)
+	if err != nil {
+		return nil, fmt.Errorf("%w filter log record '%s', %w", ErrUnableToParse, record, err)
+	}
+
+	return &entry, nil
+}
+
+func splitFirewallLogLine(line string) (string, string, error) {
+	// RFC 5424: <PRI>1 TIMESTAMP HOSTNAME filterlog PID - - RECORD
+	if strings.HasPrefix(line, "<") {
+		parts := strings.SplitN(line, " ", 8)
+		if len(parts) != 8 || parts[3] != firewallLogTag {
+			return "", "", fmt.Errorf("%w filter log line '%s'", ErrUnableToParse, line)
+		}
+
+		return parts[1], parts[7], nil
+	}
+
+	// RFC 3164: Mmm dd hh:mm:ss HOSTNAME filterlog[PID]: RECORD
+	if len(line) < len(firewallLogBSDTimestamp) {
+		return "", "", fmt.Errorf("%w filter log line '%s'", ErrUnableToParse, line)
+	}
+
+	_, record, found := strings.Cut(line, firewallLogTag)
+	if !found {
+		return "", "", fmt.Errorf("%w filter log line '%s'", ErrUnableToParse, line)
+	}
+
+	_, record, found = strings.Cut(record, ": ")
+	if !found {
+		return "", "", fmt.Errorf("%w filter log line '%s'", ErrUnableToParse, line)
+	}
+
+	return line[:len(firewallLogBSDTimestamp)], record, nil
+}
+
+func parseFirewallLogTime(timestamp string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(firewallLogBSDTimestamp, timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w filter log timestamp '%s'", ErrUnableToParse, timestamp)
+	}
+
+	t = time.Date(now.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, now.Location())
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+
+	return t, nil
+}
+
+func parseFirewallLogOptionalInt(kind string, s string) (*int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s '%s' is not a number", kind, s)
+	}
+
+	return &i, nil
+}
+
+func firewallLogField(fields []string, i int) string {
+	if i < len(fields) {
+		return fields[i]
+	}
+
+	return ""
+}
+
+// see https://docs.netgate.com/pfsense/en/latest/monitoring/logs/raw-filter-format.html.
+func (entry *FirewallLogEntry) setFields(fields []string) error {
+	var err error
+
+	if len(fields) < 9 {
+		return fmt.Errorf("expected at least 9 fields, got %d", len(fields))
+	}
+
+	entry.RuleNumber = fields[0]
+	entry.Tracker = fields[3]
+	entry.Interface = fields[4]
+	entry.Reason = fields[5]
+	entry.Action = fields[6]
+	entry.Direction = fields[7]
+
+	var protocolIndex, lengthIndex, addressIndex int
+
+	switch fields[8] {
+	case "4":
+		entry.IPVersion = 4
+		protocolIndex, lengthIndex, addressIndex = 16, 17, 18
+	case "6":
+		entry.IPVersion = 6
+		protocolIndex, lengthIndex, addressIndex = 12, 14, 15
+	default:
+		return fmt.Errorf("unknown IP version '%s'", fields[8])
+	}
+
+	if len(fields) < addressIndex+2 {
+		return fmt.Errorf("expected at least %d fields for IPv%d, got %d", addressIndex+2, entry.IPVersion, len(fields))
+	}
+
+	entry.Protocol = strings.ToLower(fields[protocolIndex])
+
+	entry.Length, err = parseFirewallLogOptionalInt("length", fields[lengthIndex])
+	if err != nil {
+		return err
+	}
+
+	entry.SourceAddress, err = netip.ParseAddr(fields[addressIndex])
+	if err != nil {
+		return fmt.Errorf("source address '%s' is not valid", fields[addressIndex])
+	}
+
+	entry.DestinationAddress, err = netip.ParseAddr(fields[addressIndex+1])
+	if err != nil {
+		return fmt.Errorf("destination address '%s' is not valid", fields[addressIndex+1])
+	}
+
+	next := addressIndex + 2
+
+	switch entry.Protocol {
+	case "tcp", "udp":
+		entry.SourcePort, err = parseFirewallLogOptionalInt("source port", firewallLogField(fields, next))
+		if err != nil {
+			return err
+		}
+
+		entry.DestinationPort, err = parseFirewallLogOptionalInt("destination port", firewallLogField(fields, next+1))
+		if err != nil {
+			return err
+		}
+
+		if entry.Protocol == "tcp" {
+			entry.TCPFlags = firewallLogField(fields, next+3)
+		}
+	case "icmp", "icmpv6", "ipv6-icmp":
+		entry.ICMPType = firewallLogField(fields, next)
+	}
+
+	return nil
+}
+
+func (pf *Client) getFirewallLog(ctx context.Context, lines int) (*FirewallLog, error) {
+	command := "$ifs = array();" +
+		"foreach (get_configured_interface_list() as $if) { $ifs[get_real_interface($if)] = $if; };" +
+		fmt.Sprintf("exec('/usr/bin/tail -n %d /var/log/filter.log', $lines);", lines) +
+		"print_r(json_encode(array('interfaces' => $ifs, 'lines' => $lines, 'now' => date('c'))));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var logResp struct {
+		Interfaces map[string]string `json:"interfaces"`
+		Lines      []string          `json:"lines"`
+		Now        string            `json:"now"`
+	}
+
+	err = json.Unmarshal(b, &logResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	now, err := time.Parse(time.RFC3339, logResp.Now)
+	if err != nil {
+		return nil, fmt.Errorf("%w current time '%s'", ErrUnableToParse, logResp.Now)
+	}
+
+	entries, skipped := ParseFirewallLog(logResp.Lines, now)
+
+	for i := range entries {
+		if iface, ok := logResp.Interfaces[entries[i].Interface]; ok {
+			entries[i].Interface = iface
+		}
+	}
+
+	return &FirewallLog{Entries: entries, Skipped: skipped}, nil
+}
+
+func (pf *Client) GetFirewallLog(ctx context.Context, filter FirewallLogFilter) (*FirewallLog, error) {
+	lines := filter.Lines
+	if lines == 0 {
+		lines = DefaultFirewallLogLines
+	}
+
+	log, err := pf.getFirewallLog(ctx, lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall log, %w", ErrGetOperationFailed, err)
+	}
+
+	log.Entries = log.Entries.Filter(filter)
+
+	return log, nil
+}
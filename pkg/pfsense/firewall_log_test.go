@@ -0,0 +1,252 @@
+package pfsense
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+const (
+	firewallLogFixtureIPv4TCP   = "Oct 18 20:58:49 pfsense filterlog[34762]: 5,,,1000000103,igb1,match,block,in,4,0x0,,64,12345,0,DF,6,tcp,60,203.0.113.5,192.0.2.10,51234,22,0,S,1234567890,,64240,,mss;sackOK;TS;nop;wscale"
+	firewallLogFixtureIPv4UDP   = "<134>1 2026-10-18T20:58:50.123456+00:00 pfsense filterlog 34762 - - 7,,,1000000104,igb0,match,pass,out,4,0x0,,64,0,0,DF,17,udp,76,192.0.2.10,198.51.100.53,33456,53,56"
+	firewallLogFixtureIPv4ICMP  = "Oct 18 20:58:51 pfsense filterlog[34762]: 8,,,1000000105,igb0,match,pass,out,4,0x0,,64,51015,0,none,1,icmp,84,192.0.2.10,198.51.100.1,request,24680,1"
+	firewallLogFixtureIPv6UDP   = "<134>1 2026-10-18T20:58:52+00:00 pfsense filterlog 34762 - - 9,,,1000000106,igb1,match,block,in,6,0x00,0x00000,1,udp,17,40,fe80::1,ff02::1:2,546,547,40"
+	firewallLogFixtureIPv6ICMP  = "Oct 18 20:58:53 pfsense filterlog[34762]: 10,,,1000000107,igb1,match,pass,in,6,0x00,0x00000,255,ipv6-icmp,58,32,fe80::1,ff02::1,neighbor-solicitation"
+	firewallLogFixtureTruncated = "Oct 18 20:58:54 pfsense filterlog[34762]: 5,,,1000000103,igb1,match,block,in,4,0x0,,64"
+	firewallLogFixtureOther     = "Oct 18 20:58:55 pfsense sshd[5521]: Accepted publickey for admin from 192.0.2.20 port 50122 ssh2"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestParseFirewallLogEntry(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		line string
+		want FirewallLogEntry
+	}{
+		{
+			name: "IPv4 TCP BSD syslog",
+			line: firewallLogFixtureIPv4TCP,
+			want: FirewallLogEntry{
+				Time:               time.Date(2026, time.October, 18, 20, 58, 49, 0, time.UTC),
+				RuleNumber:         "5",
+				Tracker:            "1000000103",
+				Interface:          "igb1",
+				Reason:             "match",
+				Action:             FirewallLogActionBlock,
+				Direction:          "in",
+				IPVersion:          4,
+				Protocol:           "tcp",
+				Length:             intPtr(60),
+				SourceAddress:      netip.MustParseAddr("203.0.113.5"),
+				DestinationAddress: netip.MustParseAddr("192.0.2.10"),
+				SourcePort:         intPtr(51234),
+				DestinationPort:    intPtr(22),
+				TCPFlags:           "S",
+			},
+		},
+		{
+			name: "IPv4 UDP RFC 5424 syslog",
+			line: firewallLogFixtureIPv4UDP,
+			want: FirewallLogEntry{
+				Time:               time.Date(2026, time.October, 18, 20, 58, 50, 123456000, time.UTC),
+				RuleNumber:         "7",
+				Tracker:            "1000000104",
+				Interface:          "igb0",
+				Reason:             "match",
+				Action:             FirewallLogActionPass,
+				Direction:          "out",
+				IPVersion:          4,
+				Protocol:           "udp",
+				Length:             intPtr(76),
+				SourceAddress:      netip.MustParseAddr("192.0.2.10"),
+				DestinationAddress: netip.MustParseAddr("198.51.100.53"),
+				SourcePort:         intPtr(33456),
+				DestinationPort:    intPtr(53),
+			},
+		},
+		{
+			name: "IPv4 ICMP BSD syslog",
+			line: firewallLogFixtureIPv4ICMP,
+			want: FirewallLogEntry{
+				Time:               time.Date(2026, time.October, 18, 20, 58, 51, 0, time.UTC),
+				RuleNumber:         "8",
+				Tracker:            "1000000105",
+				Interface:          "igb0",
+				Reason:             "match",
+				Action:             FirewallLogActionPass,
+				Direction:          "out",
+				IPVersion:          4,
+				Protocol:           "icmp",
+				Length:             intPtr(84),
+				SourceAddress:      netip.MustParseAddr("192.0.2.10"),
+				DestinationAddress: netip.MustParseAddr("198.51.100.1"),
+				ICMPType:           "request",
+			},
+		},
+		{
+			name: "IPv6 UDP RFC 5424 syslog",
+			line: firewallLogFixtureIPv6UDP,
+			want: FirewallLogEntry{
+				Time:               time.Date(2026, time.October, 18, 20, 58, 52, 0, time.UTC),
+				RuleNumber:         "9",
+				Tracker:            "1000000106",
+				Interface:          "igb1",
+				Reason:             "match",
+				Action:             FirewallLogActionBlock,
+				Direction:          "in",
+				IPVersion:          6,
+				Protocol:           "udp",
+				Length:             intPtr(40),
+				SourceAddress:      netip.MustParseAddr("fe80::1"),
+				DestinationAddress: netip.MustParseAddr("ff02::1:2"),
+				SourcePort:         intPtr(546),
+				DestinationPort:    intPtr(547),
+			},
+		},
+		{
+			name: "IPv6 ICMP BSD syslog",
+			line: firewallLogFixtureIPv6ICMP,
+			want: FirewallLogEntry{
+				Time:               time.Date(2026, time.October, 18, 20, 58, 53, 0, time.UTC),
+				RuleNumber:         "10",
+				Tracker:            "1000000107",
+				Interface:          "igb1",
+				Reason:             "match",
+				Action:             FirewallLogActionPass,
+				Direction:          "in",
+				IPVersion:          6,
+				Protocol:           "ipv6-icmp",
+				Length:             intPtr(32),
+				SourceAddress:      netip.MustParseAddr("fe80::1"),
+				DestinationAddress: netip.MustParseAddr("ff02::1"),
+				ICMPType:           "neighbor-solicitation",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFirewallLogEntry(tt.line, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertFirewallLogEntry(t, *got, tt.want)
+		})
+	}
+}
+
+func TestParseFirewallLogEntryMalformed(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]string{
+		"truncated":          firewallLogFixtureTruncated,
+		"unknown IP version": "Oct 18 20:58:56 pfsense filterlog[34762]: 5,,,1000000103,igb1,match,block,in,5,0x0,,64,12345,0,DF,6,tcp,60,203.0.113.5,192.0.2.10,51234,22,0,S",
+		"invalid address":    "Oct 18 20:58:57 pfsense filterlog[34762]: 5,,,1000000103,igb1,match,block,in,4,0x0,,64,12345,0,DF,6,tcp,60,203.0.113.500,192.0.2.10,51234,22,0,S",
+		"missing record":     "Oct 18 20:58:58 pfsense filterlog[34762]",
+	}
+
+	for name, line := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseFirewallLogEntry(line, now)
+			if !errors.Is(err, ErrUnableToParse) {
+				t.Fatalf("expected %v, got %v", ErrUnableToParse, err)
+			}
+		})
+	}
+}
+
+func TestParseFirewallLog(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	lines := []string{
+		firewallLogFixtureIPv4TCP,
+		firewallLogFixtureOther,
+		firewallLogFixtureTruncated,
+		firewallLogFixtureIPv4UDP,
+		firewallLogFixtureIPv6ICMP,
+	}
+
+	entries, skipped := ParseFirewallLog(lines, now)
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	if len(skipped) != 1 || !errors.Is(skipped[0], ErrUnableToParse) {
+		t.Fatalf("expected the truncated line to be skipped, got %v", skipped)
+	}
+
+	for i, tracker := range []string{"1000000103", "1000000104", "1000000107"} {
+		if entries[i].Tracker != tracker {
+			t.Errorf("entry %d: expected tracker %s, got %s", i, tracker, entries[i].Tracker)
+		}
+	}
+}
+
+func TestParseFirewallLogYearRollover(t *testing.T) {
+	now := time.Date(2027, time.January, 1, 0, 0, 30, 0, time.UTC)
+	line := "Dec 31 23:59:59 pfsense filterlog[34762]: 5,,,1000000103,igb1,match,block,in,4,0x0,,64,12345,0,DF,6,tcp,60,203.0.113.5,192.0.2.10,51234,22,0,S"
+
+	entry, err := ParseFirewallLogEntry(line, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2026, time.December, 31, 23, 59, 59, 0, time.UTC)
+	if !entry.Time.Equal(want) {
+		t.Errorf("expected time %s, got %s", want, entry.Time)
+	}
+}
+
+func assertFirewallLogEntry(t *testing.T, got FirewallLogEntry, want FirewallLogEntry) {
+	t.Helper()
+
+	if !got.Time.Equal(want.Time) {
+		t.Errorf("time: expected %s, got %s", want.Time, got.Time)
+	}
+
+	for _, field := range []struct {
+		name      string
+		got, want string
+	}{
+		{"rule number", got.RuleNumber, want.RuleNumber},
+		{"tracker", got.Tracker, want.Tracker},
+		{"interface", got.Interface, want.Interface},
+		{"reason", got.Reason, want.Reason},
+		{"action", got.Action, want.Action},
+		{"direction", got.Direction, want.Direction},
+		{"protocol", got.Protocol, want.Protocol},
+		{"source address", got.SourceAddress.String(), want.SourceAddress.String()},
+		{"destination address", got.DestinationAddress.String(), want.DestinationAddress.String()},
+		{"TCP flags", got.TCPFlags, want.TCPFlags},
+		{"ICMP type", got.ICMPType, want.ICMPType},
+	} {
+		if field.got != field.want {
+			t.Errorf("%s: expected '%s', got '%s'", field.name, field.want, field.got)
+		}
+	}
+
+	if got.IPVersion != want.IPVersion {
+		t.Errorf("IP version: expected %d, got %d", want.IPVersion, got.IPVersion)
+	}
+
+	for _, field := range []struct {
+		name      string
+		got, want *int
+	}{
+		{"length", got.Length, want.Length},
+		{"source port", got.SourcePort, want.SourcePort},
+		{"destination port", got.DestinationPort, want.DestinationPort},
+	} {
+		if (field.got == nil) != (field.want == nil) || (field.got != nil && *field.got != *field.want) {
+			t.Errorf("%s: expected %v, got %v", field.name, formatOptionalInt(field.want), formatOptionalInt(field.got))
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	natAddressAny = "any"
+)
+
+var (
+	natAddressKeywordRegex = regexp.MustCompile(`^\(?[a-zA-Z0-9_]+\)?$`)
+	natPortRegex           = regexp.MustCompile(`^[0-9]+$`)
+	natIDTagRegex          = regexp.MustCompile(`\s*\[tf:([^\]\s]+)\]$`)
+)
+
+type natCreatedResponse struct {
+	Time     string `json:"time"`
+	Username string `json:"username"`
+}
+
+type natEndpointResponse struct {
+	Any     *string `json:"any"`
+	Address string  `json:"address"`
+	Network string  `json:"network"`
+	Port    string  `json:"port"`
+	Not     *string `json:"not"`
+}
+
+func (resp natEndpointResponse) address() string {
+	if resp.Network != "" {
+		return resp.Network
+	}
+
+	if resp.Address != "" {
+		return resp.Address
+	}
+
+	return natAddressAny
+}
+
+func validateNATAddress(addr string) error {
+	if addr == natAddressAny {
+		return nil
+	}
+
+	if _, err := netip.ParseAddr(addr); err == nil {
+		return nil
+	}
+
+	if strings.Contains(addr, "/") {
+		prefix, err := netip.ParsePrefix(addr)
+		if err != nil {
+			return fmt.Errorf("%w, invalid CIDR '%s'", ErrClientValidation, addr)
+		}
+
+		if prefix.Masked() != prefix {
+			return fmt.Errorf("%w, CIDR '%s' has host bits set, use '%s'", ErrClientValidation, addr, prefix.Masked())
+		}
+
+		return nil
+	}
+
+	if natAddressKeywordRegex.MatchString(addr) {
+		return nil
+	}
+
+	return fmt.Errorf("%w, address '%s' must be 'any', an IP address, CIDR, alias, or interface keyword", ErrClientValidation, addr)
+}
+
+func validateNATPort(port string) error {
+	if port == "" {
+		return nil
+	}
+
+	parsePort := func(p string) (int, error) {
+		if !natPortRegex.MatchString(p) {
+			return 0, fmt.Errorf("%w, invalid port '%s'", ErrClientValidation, p)
+		}
+		i, err := strconv.Atoi(p)
+		if err != nil || i < 1 || i > 65535 {
+			return 0, fmt.Errorf("%w, port '%s' must be between 1 and 65535", ErrClientValidation, p)
+		}
+		return i, nil
+	}
+
+	if before, after, found := strings.Cut(port, "-"); found {
+		start, err := parsePort(before)
+		if err != nil {
+			return err
+		}
+
+		end, err := parsePort(after)
+		if err != nil {
+			return err
+		}
+
+		if end < start {
+			return fmt.Errorf("%w, port range '%s' ends before it starts", ErrClientValidation, port)
+		}
+
+		return nil
+	}
+
+	if _, err := parsePort(port); err == nil {
+		return nil
+	}
+
+	if aliasNameRegex.MatchString(port) {
+		return nil
+	}
+
+	return fmt.Errorf("%w, port '%s' must be a port, port range, or alias", ErrClientValidation, port)
+}
+
+func setNATAddressValues(v url.Values, prefix string, addr string, keywords map[string]bool) {
+	switch {
+	case addr == natAddressAny || keywords[addr]:
+		v.Set(fmt.Sprintf("%stype", prefix), addr)
+	case strings.Contains(addr, "/"):
+		network, mask, _ := strings.Cut(addr, "/")
+		v.Set(fmt.Sprintf("%stype", prefix), "network")
+		v.Set(prefix, network)
+		v.Set(fmt.Sprintf("%smask", prefix), mask)
+	default:
+		v.Set(fmt.Sprintf("%stype", prefix), "single")
+		v.Set(prefix, addr)
+	}
+}
+
+func setNATPortValues(v url.Values, prefix string, port string) {
+	if port == "" {
+		v.Set(fmt.Sprintf("%sbeginport", prefix), natAddressAny)
+		v.Set(fmt.Sprintf("%sendport", prefix), natAddressAny)
+		return
+	}
+
+	start, end, found := strings.Cut(port, "-")
+	if !found {
+		end = start
+	}
+
+	v.Set(fmt.Sprintf("%sbeginport", prefix), "")
+	v.Set(fmt.Sprintf("%sbeginport_cust", prefix), start)
+	v.Set(fmt.Sprintf("%sendport", prefix), "")
+	v.Set(fmt.Sprintf("%sendport_cust", prefix), end)
+}
+
+// pfSense NAT entries have no stable identifier, the creation time has one second resolution and can be missing or
+// duplicated by restores and GUI copies.
+func formatNATDescription(description string, id string) string {
+	return strings.TrimSpace(fmt.Sprintf("%s [tf:%s]", description, id))
+}
+
+// entries created outside of the provider are identified by their creation time until they are next updated.
+func parseNATDescription(descr string, created natCreatedResponse) (string, string) {
+	match := natIDTagRegex.FindStringSubmatch(descr)
+	if match == nil {
+		return descr, created.Time
+	}
+
+	return strings.TrimSuffix(descr, match[0]), match[1]
+}
+
+func (pf *Client) getNATAddressKeywords(ctx context.Context) (map[string]bool, error) {
+	b, err := pf.getConfigJSON(ctx, "['interfaces']")
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaces map[string]json.RawMessage
+	err = json.Unmarshal(b, &interfaces)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	keywords := map[string]bool{
+		"(self)": true,
+		"pppoe":  true,
+		"l2tp":   true,
+	}
+
+	for name := range interfaces {
+		keywords[name] = true
+		keywords[fmt.Sprintf("%sip", name)] = true
+	}
+
+	return keywords, nil
+}
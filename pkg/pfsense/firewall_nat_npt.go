@@ -0,0 +1,359 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type natNPTEndpointResponse struct {
+	Address string  `json:"address"`
+	Network string  `json:"network"`
+	Not     *string `json:"not"`
+}
+
+type natNPTResponse struct {
+	Interface   string                 `json:"interface"`
+	Source      natNPTEndpointResponse `json:"source"`
+	Destination natNPTEndpointResponse `json:"destination"`
+	Description string                 `json:"descr"`
+	Disabled    *string                `json:"disabled"`
+	Created     natCreatedResponse     `json:"created"`
+	ControlID   int                    `json:"controlID"`
+}
+
+type FirewallNATNPT struct {
+	ID             string
+	Interface      string
+	InternalPrefix netip.Prefix
+	InternalInvert bool
+	ExternalPrefix netip.Prefix
+	ExternalInvert bool
+	Description    string
+	Disabled       bool
+	controlID      int
+}
+
+func parseNATIPv6Prefix(name string, prefix string) (netip.Prefix, error) {
+	if !strings.Contains(prefix, "/") {
+		return netip.Prefix{}, fmt.Errorf("%w, %s '%s' must be a CIDR", ErrClientValidation, name, prefix)
+	}
+
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%w, %s '%s' is not a valid CIDR", ErrClientValidation, name, prefix)
+	}
+
+	if !p.Addr().Is6() || p.Addr().Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("%w, %s '%s' must be an IPv6 prefix", ErrClientValidation, name, prefix)
+	}
+
+	if p.Masked() != p {
+		return netip.Prefix{}, fmt.Errorf("%w, %s '%s' has host bits set, use '%s'", ErrClientValidation, name, prefix, p.Masked())
+	}
+
+	return p, nil
+}
+
+func (n *FirewallNATNPT) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	n.Interface = iface
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetInternalPrefix(prefix string) error {
+	p, err := parseNATIPv6Prefix("internal prefix", prefix)
+	if err != nil {
+		return err
+	}
+
+	n.InternalPrefix = p
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetInternalInvert(invert bool) error {
+	n.InternalInvert = invert
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetExternalPrefix(prefix string) error {
+	p, err := parseNATIPv6Prefix("external prefix", prefix)
+	if err != nil {
+		return err
+	}
+
+	n.ExternalPrefix = p
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetExternalInvert(invert bool) error {
+	n.ExternalInvert = invert
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetDescription(description string) error {
+	n.Description = description
+
+	return nil
+}
+
+func (n *FirewallNATNPT) SetDisabled(disabled bool) error {
+	n.Disabled = disabled
+
+	return nil
+}
+
+func (n *FirewallNATNPT) setFromConfig(resp natNPTResponse) {
+	n.Description, n.ID = parseNATDescription(resp.Description, resp.Created)
+	n.controlID = resp.ControlID
+	n.Interface = resp.Interface
+	n.InternalPrefix, _ = netip.ParsePrefix(resp.Source.Address)
+	n.InternalInvert = resp.Source.Not != nil
+	n.ExternalPrefix, _ = netip.ParsePrefix(resp.Destination.Address)
+	n.ExternalInvert = resp.Destination.Not != nil
+	n.Disabled = resp.Disabled != nil
+}
+
+// Validate checks the prefix lengths match, NPt translates prefixes one to one.
+func (n FirewallNATNPT) Validate() error {
+	if n.InternalPrefix.Bits() != n.ExternalPrefix.Bits() {
+		return fmt.Errorf("%w, internal prefix '%s' and external prefix '%s' must be the same length", ErrClientValidation, n.InternalPrefix, n.ExternalPrefix)
+	}
+
+	return nil
+}
+
+type FirewallNATNPTs []FirewallNATNPT
+
+func (ns FirewallNATNPTs) GetByID(id string) (*FirewallNATNPT, error) {
+	var found *FirewallNATNPT
+	for _, n := range ns {
+		if n.ID != id {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("%w, more than one NPt mapping with ID '%s'", ErrUnableToParse, id)
+		}
+
+		n := n
+		found = &n
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("NPt mapping %w with ID '%s'", ErrNotFound, id)
+	}
+
+	return found, nil
+}
+
+func (ns FirewallNATNPTs) GetControlIDByID(id string) (*int, error) {
+	n, err := ns.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n.controlID, nil
+}
+
+func (pf *Client) getFirewallNATNPTs(ctx context.Context) (*FirewallNATNPTs, error) {
+	command := "$output = array();" +
+		"foreach ($config['nat']['npt'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var nResp []natNPTResponse
+	err = json.Unmarshal(b, &nResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var npts FirewallNATNPTs
+	for _, resp := range nResp {
+		var npt FirewallNATNPT
+		npt.setFromConfig(resp)
+
+		npts = append(npts, npt)
+	}
+
+	return &npts, nil
+}
+
+func (pf *Client) GetFirewallNATNPTs(ctx context.Context) (*FirewallNATNPTs, error) {
+	pf.mutexes.FirewallNATNPT.Lock()
+	defer pf.mutexes.FirewallNATNPT.Unlock()
+
+	npts, err := pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mappings, %w", ErrGetOperationFailed, err)
+	}
+
+	return npts, nil
+}
+
+func (pf *Client) GetFirewallNATNPT(ctx context.Context, id string) (*FirewallNATNPT, error) {
+	pf.mutexes.FirewallNATNPT.Lock()
+	defer pf.mutexes.FirewallNATNPT.Unlock()
+
+	npts, err := pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mapping (ID '%s'), %w", ErrGetOperationFailed, id, err)
+	}
+
+	return npts.GetByID(id)
+}
+
+func (pf *Client) createOrUpdateFirewallNATNPT(ctx context.Context, nptReq FirewallNATNPT, existing *FirewallNATNPT) (*FirewallNATNPT, error) {
+	err := nptReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	if existing != nil {
+		id = existing.ID
+	}
+
+	u := url.URL{Path: "firewall_nat_npt_edit.php"}
+	v := url.Values{
+		"interface": {nptReq.Interface},
+		"src":       {nptReq.InternalPrefix.Addr().String()},
+		"srcmask":   {strconv.Itoa(nptReq.InternalPrefix.Bits())},
+		"dst":       {nptReq.ExternalPrefix.Addr().String()},
+		"dstmask":   {strconv.Itoa(nptReq.ExternalPrefix.Bits())},
+		"descr":     {formatNATDescription(nptReq.Description, id)},
+		"save":      {"Save"},
+	}
+
+	if nptReq.InternalInvert {
+		v.Set("srcnot", "yes")
+	}
+
+	if nptReq.ExternalInvert {
+		v.Set("dstnot", "yes")
+	}
+
+	if nptReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if existing != nil {
+		q := u.Query()
+		q.Set("id", strconv.Itoa(existing.controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	npts, err := pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return npts.GetByID(id)
+}
+
+func (pf *Client) CreateFirewallNATNPT(ctx context.Context, nptReq FirewallNATNPT) (*FirewallNATNPT, error) {
+	pf.mutexes.FirewallNATNPT.Lock()
+	defer pf.mutexes.FirewallNATNPT.Unlock()
+
+	npt, err := pf.createOrUpdateFirewallNATNPT(ctx, nptReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mapping, %w", ErrCreateOperationFailed, err)
+	}
+
+	return npt, nil
+}
+
+func (pf *Client) UpdateFirewallNATNPT(ctx context.Context, nptReq FirewallNATNPT) (*FirewallNATNPT, error) {
+	pf.mutexes.FirewallNATNPT.Lock()
+	defer pf.mutexes.FirewallNATNPT.Unlock()
+
+	npts, err := pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := npts.GetByID(nptReq.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	npt, err := pf.createOrUpdateFirewallNATNPT(ctx, nptReq, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%w NPt mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return npt, nil
+}
+
+func (pf *Client) DeleteFirewallNATNPT(ctx context.Context, id string) error {
+	pf.mutexes.FirewallNATNPT.Lock()
+	defer pf.mutexes.FirewallNATNPT.Unlock()
+
+	npts, err := pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w NPt mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := npts.GetControlIDByID(id)
+	if err != nil {
+		return fmt.Errorf("%w NPt mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat_npt.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w NPt mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w NPt mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	npts, err = pf.getFirewallNATNPTs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w NPt mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err = npts.GetByID(id); err == nil {
+		return fmt.Errorf("%w NPt mapping, '%s' still exists", ErrDeleteOperationFailed, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,408 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	natIPProtocolIPv4 = "inet"
+	natIPProtocolIPv6 = "inet6"
+)
+
+type natOneToOneResponse struct {
+	Interface     string              `json:"interface"`
+	External      string              `json:"external"`
+	Source        natEndpointResponse `json:"source"`
+	Destination   natEndpointResponse `json:"destination"`
+	Description   string              `json:"descr"`
+	Disabled      *string             `json:"disabled"`
+	NATReflection string              `json:"natreflection"`
+	Created       natCreatedResponse  `json:"created"`
+	ControlID     int                 `json:"controlID"`
+}
+
+type FirewallNATOneToOne struct {
+	ID                 string
+	Interface          string
+	ExternalAddress    netip.Addr
+	InternalAddress    string
+	InternalInvert     bool
+	DestinationAddress string
+	DestinationInvert  bool
+	Description        string
+	Disabled           bool
+	NATReflection      string
+	controlID          int
+}
+
+func (o *FirewallNATOneToOne) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	o.Interface = iface
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetExternalAddress(addr string) error {
+	externalAddress, err := netip.ParseAddr(addr)
+	if err != nil {
+		return fmt.Errorf("%w, external address '%s' must be an IP address", ErrClientValidation, addr)
+	}
+
+	o.ExternalAddress = externalAddress
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetInternalAddress(addr string) error {
+	if addr == natAddressAny {
+		return fmt.Errorf("%w, internal address must be an IP address, CIDR, or interface keyword", ErrClientValidation)
+	}
+
+	if err := validateNATAddress(addr); err != nil {
+		return err
+	}
+
+	if prefix, err := netip.ParsePrefix(addr); err == nil && prefix.IsSingleIP() {
+		return fmt.Errorf("%w, CIDR '%s' contains a single host, use '%s'", ErrClientValidation, addr, prefix.Addr())
+	}
+
+	o.InternalAddress = addr
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetInternalInvert(invert bool) error {
+	o.InternalInvert = invert
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetDestinationAddress(addr string) error {
+	if err := validateNATAddress(addr); err != nil {
+		return err
+	}
+
+	if prefix, err := netip.ParsePrefix(addr); err == nil && prefix.IsSingleIP() {
+		return fmt.Errorf("%w, CIDR '%s' contains a single host, use '%s'", ErrClientValidation, addr, prefix.Addr())
+	}
+
+	o.DestinationAddress = addr
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetDestinationInvert(invert bool) error {
+	o.DestinationInvert = invert
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetDescription(description string) error {
+	o.Description = description
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetDisabled(disabled bool) error {
+	o.Disabled = disabled
+
+	return nil
+}
+
+func (o *FirewallNATOneToOne) SetNATReflection(mode string) error {
+	switch mode {
+	case "":
+		o.NATReflection = NATReflectionDefault
+	case NATReflectionDefault, NATReflectionEnable, NATReflectionDisable:
+		o.NATReflection = mode
+	default:
+		return fmt.Errorf("%w, NAT reflection must be one of '%s', '%s', or '%s'", ErrClientValidation, NATReflectionDefault, NATReflectionEnable, NATReflectionDisable)
+	}
+
+	return nil
+}
+
+// Validate checks the external address against the internal address, a CIDR internal address maps to an external subnet of the same size.
+func (o FirewallNATOneToOne) Validate() error {
+	if addr, err := netip.ParseAddr(o.InternalAddress); err == nil {
+		if addr.Is4() != o.ExternalAddress.Is4() {
+			return fmt.Errorf("%w, external address '%s' and internal address '%s' must be the same address family", ErrClientValidation, o.ExternalAddress, o.InternalAddress)
+		}
+	}
+
+	if prefix, err := netip.ParsePrefix(o.InternalAddress); err == nil {
+		if prefix.Addr().Is4() != o.ExternalAddress.Is4() {
+			return fmt.Errorf("%w, external address '%s' and internal address '%s' must be the same address family", ErrClientValidation, o.ExternalAddress, o.InternalAddress)
+		}
+
+		external := netip.PrefixFrom(o.ExternalAddress, prefix.Bits())
+		if external.Masked().Addr() != o.ExternalAddress {
+			return fmt.Errorf("%w, external address '%s' must be the start of a /%d subnet to match internal address '%s', use '%s'", ErrClientValidation, o.ExternalAddress, prefix.Bits(), o.InternalAddress, external.Masked().Addr())
+		}
+	}
+
+	return nil
+}
+
+func (o FirewallNATOneToOne) formatIPProtocol() string {
+	if o.ExternalAddress.Is6() {
+		return natIPProtocolIPv6
+	}
+
+	return natIPProtocolIPv4
+}
+
+func (o *FirewallNATOneToOne) setFromConfig(resp natOneToOneResponse) {
+	o.Description, o.ID = parseNATDescription(resp.Description, resp.Created)
+	o.controlID = resp.ControlID
+	o.Interface = resp.Interface
+	o.ExternalAddress, _ = netip.ParseAddr(resp.External)
+	o.InternalAddress = resp.Source.address()
+	o.InternalInvert = resp.Source.Not != nil
+	o.DestinationAddress = resp.Destination.address()
+	o.DestinationInvert = resp.Destination.Not != nil
+	o.Disabled = resp.Disabled != nil
+
+	o.NATReflection = resp.NATReflection
+	if o.NATReflection == "" {
+		o.NATReflection = NATReflectionDefault
+	}
+}
+
+type FirewallNATOneToOnes []FirewallNATOneToOne
+
+func (os FirewallNATOneToOnes) GetByID(id string) (*FirewallNATOneToOne, error) {
+	var found *FirewallNATOneToOne
+	for _, o := range os {
+		if o.ID != id {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("%w, more than one 1:1 NAT mapping with ID '%s'", ErrUnableToParse, id)
+		}
+
+		o := o
+		found = &o
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("1:1 NAT mapping %w with ID '%s'", ErrNotFound, id)
+	}
+
+	return found, nil
+}
+
+func (os FirewallNATOneToOnes) GetControlIDByID(id string) (*int, error) {
+	o, err := os.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &o.controlID, nil
+}
+
+func (pf *Client) getFirewallNATOneToOnes(ctx context.Context) (*FirewallNATOneToOnes, error) {
+	command := "$output = array();" +
+		"foreach ($config['nat']['onetoone'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var oResp []natOneToOneResponse
+	err = json.Unmarshal(b, &oResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var oneToOnes FirewallNATOneToOnes
+	for _, resp := range oResp {
+		var oneToOne FirewallNATOneToOne
+		oneToOne.setFromConfig(resp)
+
+		oneToOnes = append(oneToOnes, oneToOne)
+	}
+
+	return &oneToOnes, nil
+}
+
+func (pf *Client) GetFirewallNATOneToOnes(ctx context.Context) (*FirewallNATOneToOnes, error) {
+	pf.mutexes.FirewallNATOneToOne.Lock()
+	defer pf.mutexes.FirewallNATOneToOne.Unlock()
+
+	oneToOnes, err := pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mappings, %w", ErrGetOperationFailed, err)
+	}
+
+	return oneToOnes, nil
+}
+
+func (pf *Client) GetFirewallNATOneToOne(ctx context.Context, id string) (*FirewallNATOneToOne, error) {
+	pf.mutexes.FirewallNATOneToOne.Lock()
+	defer pf.mutexes.FirewallNATOneToOne.Unlock()
+
+	oneToOnes, err := pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mapping (ID '%s'), %w", ErrGetOperationFailed, id, err)
+	}
+
+	return oneToOnes.GetByID(id)
+}
+
+func (pf *Client) createOrUpdateFirewallNATOneToOne(ctx context.Context, oneToOneReq FirewallNATOneToOne, existing *FirewallNATOneToOne) (*FirewallNATOneToOne, error) {
+	err := oneToOneReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	keywords, err := pf.getNATAddressKeywords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	if existing != nil {
+		id = existing.ID
+	}
+
+	u := url.URL{Path: "firewall_nat_1to1_edit.php"}
+	v := url.Values{
+		"interface":     {oneToOneReq.Interface},
+		"ipprotocol":    {oneToOneReq.formatIPProtocol()},
+		"external":      {oneToOneReq.ExternalAddress.String()},
+		"descr":         {formatNATDescription(oneToOneReq.Description, id)},
+		"natreflection": {oneToOneReq.NATReflection},
+		"save":          {"Save"},
+	}
+
+	setNATAddressValues(v, "src", oneToOneReq.InternalAddress, keywords)
+	setNATAddressValues(v, "dst", oneToOneReq.DestinationAddress, keywords)
+
+	if oneToOneReq.InternalInvert {
+		v.Set("srcnot", "yes")
+	}
+
+	if oneToOneReq.DestinationInvert {
+		v.Set("dstnot", "yes")
+	}
+
+	if oneToOneReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if existing != nil {
+		q := u.Query()
+		q.Set("id", strconv.Itoa(existing.controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	oneToOnes, err := pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return oneToOnes.GetByID(id)
+}
+
+func (pf *Client) CreateFirewallNATOneToOne(ctx context.Context, oneToOneReq FirewallNATOneToOne) (*FirewallNATOneToOne, error) {
+	pf.mutexes.FirewallNATOneToOne.Lock()
+	defer pf.mutexes.FirewallNATOneToOne.Unlock()
+
+	oneToOne, err := pf.createOrUpdateFirewallNATOneToOne(ctx, oneToOneReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mapping, %w", ErrCreateOperationFailed, err)
+	}
+
+	return oneToOne, nil
+}
+
+func (pf *Client) UpdateFirewallNATOneToOne(ctx context.Context, oneToOneReq FirewallNATOneToOne) (*FirewallNATOneToOne, error) {
+	pf.mutexes.FirewallNATOneToOne.Lock()
+	defer pf.mutexes.FirewallNATOneToOne.Unlock()
+
+	oneToOnes, err := pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := oneToOnes.GetByID(oneToOneReq.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	oneToOne, err := pf.createOrUpdateFirewallNATOneToOne(ctx, oneToOneReq, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%w 1:1 NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return oneToOne, nil
+}
+
+func (pf *Client) DeleteFirewallNATOneToOne(ctx context.Context, id string) error {
+	pf.mutexes.FirewallNATOneToOne.Lock()
+	defer pf.mutexes.FirewallNATOneToOne.Unlock()
+
+	oneToOnes, err := pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := oneToOnes.GetControlIDByID(id)
+	if err != nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat_1to1.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	oneToOnes, err = pf.getFirewallNATOneToOnes(ctx)
+	if err != nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err = oneToOnes.GetByID(id); err == nil {
+		return fmt.Errorf("%w 1:1 NAT mapping, '%s' still exists", ErrDeleteOperationFailed, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,769 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NATOutboundModeAutomatic = "automatic"
+	NATOutboundModeHybrid    = "hybrid"
+	NATOutboundModeManual    = "manual"
+	NATOutboundModeDisabled  = "disabled"
+
+	NATOutboundPoolOptionsRoundRobin       = "round-robin"
+	NATOutboundPoolOptionsRoundRobinSticky = "round-robin sticky-address"
+	NATOutboundPoolOptionsRandom           = "random"
+	NATOutboundPoolOptionsRandomSticky     = "random sticky-address"
+	NATOutboundPoolOptionsSourceHash       = "source-hash"
+	NATOutboundPoolOptionsBitmask          = "bitmask"
+
+	NATOutboundSourceSelf = "(self)"
+)
+
+const (
+	natOutboundModeAdvanced    = "advanced"
+	natOutboundTargetSubnet    = "other-subnet"
+	natOutboundTypeNetwork     = "network"
+	natOutboundProtocolDefault = "any"
+)
+
+var NATOutboundProtocols = append([]string{natOutboundProtocolDefault}, NATProtocols...)
+
+var NATOutboundPoolOptions = []string{
+	NATOutboundPoolOptionsRoundRobin,
+	NATOutboundPoolOptionsRoundRobinSticky,
+	NATOutboundPoolOptionsRandom,
+	NATOutboundPoolOptionsRandomSticky,
+	NATOutboundPoolOptionsSourceHash,
+	NATOutboundPoolOptionsBitmask,
+}
+
+type natOutboundMappingResponse struct {
+	Interface      string              `json:"interface"`
+	Protocol       string              `json:"protocol"`
+	Source         natEndpointResponse `json:"source"`
+	SourcePort     string              `json:"sourceport"`
+	Destination    natEndpointResponse `json:"destination"`
+	DstPort        string              `json:"dstport"`
+	Target         string              `json:"target"`
+	TargetIP       string              `json:"targetip"`
+	TargetIPSubnet string              `json:"targetip_subnet"`
+	NATPort        string              `json:"natport"`
+	PoolOptions    string              `json:"poolopts"`
+	StaticNATPort  *string             `json:"staticnatport"`
+	NoNAT          *string             `json:"nonat"`
+	Disabled       *string             `json:"disabled"`
+	Description    string              `json:"descr"`
+	Created        natCreatedResponse  `json:"created"`
+	ControlID      int                 `json:"controlID"`
+}
+
+func formatNATOutboundPrefix(addr string, bits string) string {
+	prefix, err := netip.ParsePrefix(fmt.Sprintf("%s/%s", addr, bits))
+	if err != nil {
+		return addr
+	}
+
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String()
+	}
+
+	return prefix.String()
+}
+
+// single host CIDRs are rejected as pfSense stores them as a bare address.
+func validateNATOutboundAddress(addr string) error {
+	if _, err := netip.ParseAddr(addr); err == nil {
+		return nil
+	}
+
+	if strings.Contains(addr, "/") {
+		prefix, err := netip.ParsePrefix(addr)
+		if err != nil {
+			return fmt.Errorf("%w, invalid CIDR '%s'", ErrClientValidation, addr)
+		}
+
+		if prefix.Masked() != prefix {
+			return fmt.Errorf("%w, CIDR '%s' has host bits set, use '%s'", ErrClientValidation, addr, prefix.Masked())
+		}
+
+		if prefix.IsSingleIP() {
+			return fmt.Errorf("%w, CIDR '%s' contains a single host, use '%s'", ErrClientValidation, addr, prefix.Addr())
+		}
+
+		return nil
+	}
+
+	if aliasNameRegex.MatchString(addr) {
+		return nil
+	}
+
+	return fmt.Errorf("%w, address '%s' must be an IP address, CIDR, or alias", ErrClientValidation, addr)
+}
+
+func setNATOutboundAddressValues(v url.Values, prefix string, addr string) {
+	if addr == natAddressAny || addr == NATOutboundSourceSelf {
+		v.Set(fmt.Sprintf("%s_type", prefix), addr)
+		return
+	}
+
+	v.Set(fmt.Sprintf("%s_type", prefix), natOutboundTypeNetwork)
+
+	if p, err := netip.ParsePrefix(addr); err == nil {
+		v.Set(prefix, p.Addr().String())
+		v.Set(fmt.Sprintf("%s_subnet", prefix), strconv.Itoa(p.Bits()))
+		return
+	}
+
+	v.Set(prefix, addr)
+	if a, err := netip.ParseAddr(addr); err == nil {
+		v.Set(fmt.Sprintf("%s_subnet", prefix), strconv.Itoa(a.BitLen()))
+	}
+}
+
+func (pf *Client) getFirewallNATOutboundMode(ctx context.Context) (*string, error) {
+	b, err := pf.getConfigJSON(ctx, "['nat']['outbound']['mode']")
+	if err != nil {
+		return nil, err
+	}
+
+	var modeResp *string
+	err = json.Unmarshal(b, &modeResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	mode := NATOutboundModeAutomatic
+	if modeResp != nil {
+		switch *modeResp {
+		case natOutboundModeAdvanced:
+			mode = NATOutboundModeManual
+		case NATOutboundModeAutomatic, NATOutboundModeHybrid, NATOutboundModeDisabled:
+			mode = *modeResp
+		case "":
+		default:
+			return nil, fmt.Errorf("%w outbound NAT mode '%s'", ErrUnableToParse, *modeResp)
+		}
+	}
+
+	return &mode, nil
+}
+
+func (pf *Client) GetFirewallNATOutboundMode(ctx context.Context) (*string, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mode, err := pf.getFirewallNATOutboundMode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mode, %w", ErrGetOperationFailed, err)
+	}
+
+	return mode, nil
+}
+
+func ValidateNATOutboundMode(mode string) error {
+	switch mode {
+	case NATOutboundModeAutomatic, NATOutboundModeHybrid, NATOutboundModeManual, NATOutboundModeDisabled:
+		return nil
+	default:
+		return fmt.Errorf("%w, outbound NAT mode must be one of '%s', '%s', '%s', or '%s'", ErrClientValidation, NATOutboundModeAutomatic, NATOutboundModeHybrid, NATOutboundModeManual, NATOutboundModeDisabled)
+	}
+}
+
+func (pf *Client) UpdateFirewallNATOutboundMode(ctx context.Context, mode string) (*string, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	err := ValidateNATOutboundMode(mode)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	formMode := mode
+	if mode == NATOutboundModeManual {
+		formMode = natOutboundModeAdvanced
+	}
+
+	u := url.URL{Path: "firewall_nat_out.php"}
+	v := url.Values{
+		"mode": {formMode},
+		"save": {"Save"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	updated, err := pf.getFirewallNATOutboundMode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if *updated != mode {
+		return nil, fmt.Errorf("%w outbound NAT mode, expected '%s' but found '%s'", ErrUpdateOperationFailed, mode, *updated)
+	}
+
+	return updated, nil
+}
+
+type FirewallNATOutboundMapping struct {
+	ID                 string
+	Interface          string
+	Protocol           string
+	SourceAddress      string
+	SourcePort         string
+	DestinationAddress string
+	DestinationPort    string
+	DestinationInvert  bool
+	TranslationAddress string
+	TranslationPort    string
+	PoolOptions        string
+	StaticPort         bool
+	NoNAT              bool
+	Description        string
+	Disabled           bool
+	After              string
+	controlID          int
+}
+
+func (m *FirewallNATOutboundMapping) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	m.Interface = iface
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetProtocol(protocol string) error {
+	if protocol == "" {
+		m.Protocol = natOutboundProtocolDefault
+		return nil
+	}
+
+	for _, p := range NATOutboundProtocols {
+		if p == protocol {
+			m.Protocol = protocol
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, protocol must be one of '%s'", ErrClientValidation, strings.Join(NATOutboundProtocols, "', '"))
+}
+
+func (m *FirewallNATOutboundMapping) SetSourceAddress(addr string) error {
+	if addr != natAddressAny && addr != NATOutboundSourceSelf {
+		if err := validateNATOutboundAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	m.SourceAddress = addr
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetSourcePort(port string) error {
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	m.SourcePort = port
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetDestinationAddress(addr string) error {
+	if addr != natAddressAny {
+		if err := validateNATOutboundAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	m.DestinationAddress = addr
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetDestinationPort(port string) error {
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	m.DestinationPort = port
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetDestinationInvert(invert bool) error {
+	m.DestinationInvert = invert
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetTranslationAddress(addr string) error {
+	if addr != "" {
+		if err := validateNATOutboundAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	m.TranslationAddress = addr
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetTranslationPort(port string) error {
+	if strings.Contains(port, "-") {
+		return fmt.Errorf("%w, translation port must be a single port or alias", ErrClientValidation)
+	}
+
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	m.TranslationPort = port
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetPoolOptions(options string) error {
+	if options == "" {
+		m.PoolOptions = options
+		return nil
+	}
+
+	for _, o := range NATOutboundPoolOptions {
+		if o == options {
+			m.PoolOptions = options
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, pool options must be one of '%s'", ErrClientValidation, strings.Join(NATOutboundPoolOptions, "', '"))
+}
+
+func (m *FirewallNATOutboundMapping) SetStaticPort(staticPort bool) error {
+	m.StaticPort = staticPort
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetNoNAT(noNAT bool) error {
+	m.NoNAT = noNAT
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetDescription(description string) error {
+	m.Description = description
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) SetDisabled(disabled bool) error {
+	m.Disabled = disabled
+
+	return nil
+}
+
+func (m *FirewallNATOutboundMapping) setFromConfig(resp natOutboundMappingResponse) {
+	m.Description, m.ID = parseNATDescription(resp.Description, resp.Created)
+	m.controlID = resp.ControlID
+	m.Interface = resp.Interface
+
+	m.Protocol = resp.Protocol
+	if m.Protocol == "" {
+		m.Protocol = natOutboundProtocolDefault
+	}
+
+	m.SourceAddress = resp.Source.address()
+	if resp.Source.Network != "" && resp.Source.Network != NATOutboundSourceSelf {
+		addr, bits, _ := strings.Cut(resp.Source.Network, "/")
+		m.SourceAddress = formatNATOutboundPrefix(addr, bits)
+	}
+
+	m.DestinationAddress = resp.Destination.address()
+	if m.DestinationAddress != natAddressAny {
+		addr, bits, _ := strings.Cut(m.DestinationAddress, "/")
+		m.DestinationAddress = formatNATOutboundPrefix(addr, bits)
+	}
+
+	m.TranslationAddress = resp.Target
+	if resp.Target == natOutboundTargetSubnet {
+		m.TranslationAddress = formatNATOutboundPrefix(resp.TargetIP, resp.TargetIPSubnet)
+	}
+
+	m.SourcePort = resp.SourcePort
+	m.DestinationPort = resp.DstPort
+	m.DestinationInvert = resp.Destination.Not != nil
+	m.TranslationPort = resp.NATPort
+	m.PoolOptions = resp.PoolOptions
+	m.StaticPort = resp.StaticNATPort != nil
+	m.NoNAT = resp.NoNAT != nil
+	m.Disabled = resp.Disabled != nil
+}
+
+// Validate checks combinations of fields which pfSense rejects.
+func (m FirewallNATOutboundMapping) Validate() error {
+	if m.StaticPort && m.TranslationPort != "" {
+		return fmt.Errorf("%w, static port and translation port are mutually exclusive", ErrClientValidation)
+	}
+
+	if m.NoNAT && (m.TranslationAddress != "" || m.TranslationPort != "" || m.PoolOptions != "" || m.StaticPort) {
+		return fmt.Errorf("%w, translation settings cannot be used when NAT is disabled for the mapping", ErrClientValidation)
+	}
+
+	return nil
+}
+
+type FirewallNATOutboundMappings []FirewallNATOutboundMapping
+
+func (ms FirewallNATOutboundMappings) GetByID(id string) (*FirewallNATOutboundMapping, error) {
+	var found *FirewallNATOutboundMapping
+	for _, m := range ms {
+		if m.ID != id {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("%w, more than one outbound NAT mapping with ID '%s'", ErrUnableToParse, id)
+		}
+
+		m := m
+		found = &m
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("outbound NAT mapping %w with ID '%s'", ErrNotFound, id)
+	}
+
+	return found, nil
+}
+
+func (ms FirewallNATOutboundMappings) GetControlIDByID(id string) (*int, error) {
+	m, err := ms.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m.controlID, nil
+}
+
+func (ms FirewallNATOutboundMappings) order(id string, after string) ([]int, error) {
+	moving, err := ms.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if after == id {
+		return nil, fmt.Errorf("%w, outbound NAT mapping cannot be placed after itself", ErrClientValidation)
+	}
+
+	var order []int
+	if after == "" {
+		order = append(order, moving.controlID)
+	} else if _, err := ms.GetByID(after); err != nil {
+		return nil, err
+	}
+
+	for _, m := range ms {
+		if m.ID == id {
+			continue
+		}
+
+		order = append(order, m.controlID)
+
+		if after != "" && m.ID == after {
+			order = append(order, moving.controlID)
+		}
+	}
+
+	return order, nil
+}
+
+func (pf *Client) getFirewallNATOutboundMappings(ctx context.Context) (*FirewallNATOutboundMappings, error) {
+	command := "$output = array();" +
+		"foreach ($config['nat']['outbound']['rule'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var mResp []natOutboundMappingResponse
+	err = json.Unmarshal(b, &mResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var mappings FirewallNATOutboundMappings
+	var previousID string
+	for _, resp := range mResp {
+		var mapping FirewallNATOutboundMapping
+		mapping.setFromConfig(resp)
+		mapping.After = previousID
+		previousID = mapping.ID
+
+		mappings = append(mappings, mapping)
+	}
+
+	return &mappings, nil
+}
+
+func (pf *Client) GetFirewallNATOutboundMappings(ctx context.Context) (*FirewallNATOutboundMappings, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mappings, %w", ErrGetOperationFailed, err)
+	}
+
+	return mappings, nil
+}
+
+func (pf *Client) GetFirewallNATOutboundMapping(ctx context.Context, id string) (*FirewallNATOutboundMapping, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping (ID '%s'), %w", ErrGetOperationFailed, id, err)
+	}
+
+	return mappings.GetByID(id)
+}
+
+func (pf *Client) createOrUpdateFirewallNATOutboundMapping(ctx context.Context, mappingReq FirewallNATOutboundMapping, existing *FirewallNATOutboundMapping) (*FirewallNATOutboundMapping, error) {
+	err := mappingReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	if existing != nil {
+		id = existing.ID
+	}
+
+	u := url.URL{Path: "firewall_nat_out_edit.php"}
+	v := url.Values{
+		"interface":  {mappingReq.Interface},
+		"protocol":   {mappingReq.Protocol},
+		"sourceport": {mappingReq.SourcePort},
+		"dstport":    {mappingReq.DestinationPort},
+		"natport":    {mappingReq.TranslationPort},
+		"poolopts":   {mappingReq.PoolOptions},
+		"descr":      {formatNATDescription(mappingReq.Description, id)},
+		"save":       {"Save"},
+	}
+
+	setNATOutboundAddressValues(v, "source", mappingReq.SourceAddress)
+	setNATOutboundAddressValues(v, "destination", mappingReq.DestinationAddress)
+
+	if p, err := netip.ParsePrefix(mappingReq.TranslationAddress); err == nil {
+		v.Set("target", natOutboundTargetSubnet)
+		v.Set("targetip", p.Addr().String())
+		v.Set("targetip_subnet", strconv.Itoa(p.Bits()))
+	} else if a, err := netip.ParseAddr(mappingReq.TranslationAddress); err == nil {
+		v.Set("target", natOutboundTargetSubnet)
+		v.Set("targetip", a.String())
+		v.Set("targetip_subnet", strconv.Itoa(a.BitLen()))
+	} else {
+		v.Set("target", mappingReq.TranslationAddress)
+	}
+
+	if mappingReq.DestinationInvert {
+		v.Set("destination_not", "yes")
+	}
+
+	if mappingReq.StaticPort {
+		v.Set("staticnatport", "yes")
+	}
+
+	if mappingReq.NoNAT {
+		v.Set("nonat", "yes")
+	}
+
+	if mappingReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if existing != nil {
+		q := u.Query()
+		q.Set("id", strconv.Itoa(existing.controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappings.GetByID(id)
+}
+
+func (pf *Client) CreateFirewallNATOutboundMapping(ctx context.Context, mappingReq FirewallNATOutboundMapping) (*FirewallNATOutboundMapping, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mapping, err := pf.createOrUpdateFirewallNATOutboundMapping(ctx, mappingReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping, %w", ErrCreateOperationFailed, err)
+	}
+
+	return mapping, nil
+}
+
+func (pf *Client) UpdateFirewallNATOutboundMapping(ctx context.Context, mappingReq FirewallNATOutboundMapping) (*FirewallNATOutboundMapping, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := mappings.GetByID(mappingReq.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	mapping, err := pf.createOrUpdateFirewallNATOutboundMapping(ctx, mappingReq, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return mapping, nil
+}
+
+// MoveFirewallNATOutboundMapping places a mapping directly after another mapping, or first when after is empty. Mappings are evaluated in order.
+func (pf *Client) MoveFirewallNATOutboundMapping(ctx context.Context, id string, after string) (*FirewallNATOutboundMapping, error) {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	mapping, err := mappings.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if mapping.After == after {
+		return mapping, nil
+	}
+
+	order, err := mappings.order(id, after)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat_out.php"}
+	v := url.Values{
+		"order-store": {"Save"},
+	}
+
+	for _, controlID := range order {
+		v.Add("rule[]", strconv.Itoa(controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	mappings, err = pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	mapping, err = mappings.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if mapping.After != after {
+		return nil, fmt.Errorf("%w outbound NAT mapping order, mapping '%s' not placed after '%s'", ErrUpdateOperationFailed, id, after)
+	}
+
+	return mapping, nil
+}
+
+func (pf *Client) DeleteFirewallNATOutboundMapping(ctx context.Context, id string) error {
+	pf.mutexes.FirewallNATOutbound.Lock()
+	defer pf.mutexes.FirewallNATOutbound.Unlock()
+
+	mappings, err := pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := mappings.GetControlIDByID(id)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat_out.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	mappings, err = pf.getFirewallNATOutboundMappings(ctx)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT mapping, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err = mappings.GetByID(id); err == nil {
+		return fmt.Errorf("%w outbound NAT mapping, '%s' still exists", ErrDeleteOperationFailed, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,483 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NATReflectionDefault = "default"
+	NATReflectionEnable  = "enable"
+	NATReflectionPureNAT = "purenat"
+	NATReflectionDisable = "disable"
+
+	NATFilterRuleAssociationNone   = "none"
+	NATFilterRuleAssociationPass   = "pass"
+	NATFilterRuleAssociationLinked = "linked"
+)
+
+const (
+	natAssociatedRulePass   = "pass"
+	natAssociatedRuleAdd    = "add-associated"
+	natAssociatedRulePrefix = "nat_"
+)
+
+var NATProtocols = []string{"tcp", "udp", "tcp/udp", "icmp", "esp", "ah", "gre", "ipv6", "igmp", "pim", "ospf"}
+
+type natPortForwardResponse struct {
+	Interface      string              `json:"interface"`
+	Protocol       string              `json:"protocol"`
+	Source         natEndpointResponse `json:"source"`
+	Destination    natEndpointResponse `json:"destination"`
+	Target         string              `json:"target"`
+	LocalPort      string              `json:"local-port"`
+	Description    string              `json:"descr"`
+	Disabled       *string             `json:"disabled"`
+	NoRDR          *string             `json:"nordr"`
+	NATReflection  string              `json:"natreflection"`
+	AssociatedRule string              `json:"associated-rule-id"`
+	Created        natCreatedResponse  `json:"created"`
+	ControlID      int                 `json:"controlID"`
+}
+
+type FirewallNATPortForward struct {
+	ID                    string
+	Interface             string
+	Protocol              string
+	SourceAddress         string
+	SourcePort            string
+	SourceInvert          bool
+	DestinationAddress    string
+	DestinationPort       string
+	DestinationInvert     bool
+	RedirectTargetIP      string
+	RedirectTargetPort    string
+	Description           string
+	Disabled              bool
+	NoRDR                 bool
+	NATReflection         string
+	FilterRuleAssociation string
+	associatedRuleID      string
+	controlID             int
+}
+
+func (pfw *FirewallNATPortForward) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	pfw.Interface = iface
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetProtocol(protocol string) error {
+	for _, p := range NATProtocols {
+		if p == protocol {
+			pfw.Protocol = protocol
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, protocol must be one of '%s'", ErrClientValidation, strings.Join(NATProtocols, "', '"))
+}
+
+func (pfw *FirewallNATPortForward) SetSourceAddress(addr string) error {
+	if err := validateNATAddress(addr); err != nil {
+		return err
+	}
+
+	pfw.SourceAddress = addr
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetSourcePort(port string) error {
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	pfw.SourcePort = port
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetSourceInvert(invert bool) error {
+	pfw.SourceInvert = invert
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetDestinationAddress(addr string) error {
+	if err := validateNATAddress(addr); err != nil {
+		return err
+	}
+
+	pfw.DestinationAddress = addr
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetDestinationPort(port string) error {
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	pfw.DestinationPort = port
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetDestinationInvert(invert bool) error {
+	pfw.DestinationInvert = invert
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetRedirectTargetIP(target string) error {
+	if _, err := netip.ParseAddr(target); err != nil && !aliasNameRegex.MatchString(target) {
+		return fmt.Errorf("%w, redirect target '%s' must be an IP address or alias", ErrClientValidation, target)
+	}
+
+	pfw.RedirectTargetIP = target
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetRedirectTargetPort(port string) error {
+	if strings.Contains(port, "-") {
+		return fmt.Errorf("%w, redirect target port must be a single port or alias", ErrClientValidation)
+	}
+
+	if err := validateNATPort(port); err != nil {
+		return err
+	}
+
+	pfw.RedirectTargetPort = port
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetDescription(description string) error {
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetDisabled(disabled bool) error {
+	pfw.Disabled = disabled
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetNoRDR(noRDR bool) error {
+	pfw.NoRDR = noRDR
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetNATReflection(mode string) error {
+	switch mode {
+	case "":
+		pfw.NATReflection = NATReflectionDefault
+	case NATReflectionDefault, NATReflectionEnable, NATReflectionPureNAT, NATReflectionDisable:
+		pfw.NATReflection = mode
+	default:
+		return fmt.Errorf("%w, NAT reflection must be one of '%s', '%s', '%s', or '%s'", ErrClientValidation, NATReflectionDefault, NATReflectionEnable, NATReflectionPureNAT, NATReflectionDisable)
+	}
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) SetFilterRuleAssociation(association string) error {
+	switch association {
+	case NATFilterRuleAssociationNone, NATFilterRuleAssociationPass, NATFilterRuleAssociationLinked:
+		pfw.FilterRuleAssociation = association
+	default:
+		return fmt.Errorf("%w, filter rule association must be one of '%s', '%s', or '%s'", ErrClientValidation, NATFilterRuleAssociationNone, NATFilterRuleAssociationPass, NATFilterRuleAssociationLinked)
+	}
+
+	return nil
+}
+
+func (pfw *FirewallNATPortForward) setFromConfig(resp natPortForwardResponse) {
+	pfw.Description, pfw.ID = parseNATDescription(resp.Description, resp.Created)
+	pfw.controlID = resp.ControlID
+	pfw.associatedRuleID = resp.AssociatedRule
+	pfw.Interface = resp.Interface
+	pfw.Protocol = resp.Protocol
+	pfw.SourceAddress = resp.Source.address()
+	pfw.SourcePort = resp.Source.Port
+	pfw.SourceInvert = resp.Source.Not != nil
+	pfw.DestinationAddress = resp.Destination.address()
+	pfw.DestinationPort = resp.Destination.Port
+	pfw.DestinationInvert = resp.Destination.Not != nil
+	pfw.RedirectTargetIP = resp.Target
+	pfw.RedirectTargetPort = resp.LocalPort
+	pfw.Disabled = resp.Disabled != nil
+	pfw.NoRDR = resp.NoRDR != nil
+
+	pfw.NATReflection = resp.NATReflection
+	if pfw.NATReflection == "" {
+		pfw.NATReflection = NATReflectionDefault
+	}
+
+	pfw.FilterRuleAssociation = NATFilterRuleAssociationNone
+	if resp.AssociatedRule == natAssociatedRulePass {
+		pfw.FilterRuleAssociation = NATFilterRuleAssociationPass
+	} else if strings.HasPrefix(resp.AssociatedRule, natAssociatedRulePrefix) {
+		pfw.FilterRuleAssociation = NATFilterRuleAssociationLinked
+	}
+}
+
+func (pfw FirewallNATPortForward) formatAssociatedRule(existing *FirewallNATPortForward) string {
+	switch pfw.FilterRuleAssociation {
+	case NATFilterRuleAssociationPass:
+		return natAssociatedRulePass
+	case NATFilterRuleAssociationLinked:
+		if existing != nil && existing.associatedRuleID != "" {
+			return existing.associatedRuleID
+		}
+		return natAssociatedRuleAdd
+	default:
+		return ""
+	}
+}
+
+type FirewallNATPortForwards []FirewallNATPortForward
+
+func (pfws FirewallNATPortForwards) GetByID(id string) (*FirewallNATPortForward, error) {
+	var found *FirewallNATPortForward
+	for _, pfw := range pfws {
+		if pfw.ID != id {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("%w, more than one NAT port forward with ID '%s'", ErrUnableToParse, id)
+		}
+
+		pfw := pfw
+		found = &pfw
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("NAT port forward %w with ID '%s'", ErrNotFound, id)
+	}
+
+	return found, nil
+}
+
+func (pfws FirewallNATPortForwards) GetControlIDByID(id string) (*int, error) {
+	pfw, err := pfws.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pfw.controlID, nil
+}
+
+func (pf *Client) getFirewallNATPortForwards(ctx context.Context) (*FirewallNATPortForwards, error) {
+	command := "$output = array();" +
+		"foreach ($config['nat']['rule'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var pfwResp []natPortForwardResponse
+	err = json.Unmarshal(b, &pfwResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var portForwards FirewallNATPortForwards
+	for _, resp := range pfwResp {
+		var portForward FirewallNATPortForward
+		portForward.setFromConfig(resp)
+
+		portForwards = append(portForwards, portForward)
+	}
+
+	return &portForwards, nil
+}
+
+func (pf *Client) GetFirewallNATPortForwards(ctx context.Context) (*FirewallNATPortForwards, error) {
+	pf.mutexes.FirewallNATPortForward.Lock()
+	defer pf.mutexes.FirewallNATPortForward.Unlock()
+
+	portForwards, err := pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forwards, %w", ErrGetOperationFailed, err)
+	}
+
+	return portForwards, nil
+}
+
+func (pf *Client) GetFirewallNATPortForward(ctx context.Context, id string) (*FirewallNATPortForward, error) {
+	pf.mutexes.FirewallNATPortForward.Lock()
+	defer pf.mutexes.FirewallNATPortForward.Unlock()
+
+	portForwards, err := pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forward (ID '%s'), %w", ErrGetOperationFailed, id, err)
+	}
+
+	return portForwards.GetByID(id)
+}
+
+func (pf *Client) createOrUpdateFirewallNATPortForward(ctx context.Context, portForwardReq FirewallNATPortForward, existing *FirewallNATPortForward) (*FirewallNATPortForward, error) {
+	keywords, err := pf.getNATAddressKeywords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	if existing != nil {
+		id = existing.ID
+	}
+
+	u := url.URL{Path: "firewall_nat_edit.php"}
+	v := url.Values{
+		"interface":           {portForwardReq.Interface},
+		"proto":               {portForwardReq.Protocol},
+		"localip":             {portForwardReq.RedirectTargetIP},
+		"localbeginport":      {""},
+		"localbeginport_cust": {portForwardReq.RedirectTargetPort},
+		"descr":               {formatNATDescription(portForwardReq.Description, id)},
+		"natreflection":       {portForwardReq.NATReflection},
+		"associated-rule-id":  {portForwardReq.formatAssociatedRule(existing)},
+		"save":                {"Save"},
+	}
+
+	setNATAddressValues(v, "src", portForwardReq.SourceAddress, keywords)
+	setNATPortValues(v, "src", portForwardReq.SourcePort)
+	setNATAddressValues(v, "dst", portForwardReq.DestinationAddress, keywords)
+	setNATPortValues(v, "dst", portForwardReq.DestinationPort)
+
+	if portForwardReq.SourceInvert {
+		v.Set("srcnot", "yes")
+	}
+
+	if portForwardReq.DestinationInvert {
+		v.Set("dstnot", "yes")
+	}
+
+	if portForwardReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if portForwardReq.NoRDR {
+		v.Set("nordr", "yes")
+	}
+
+	if existing != nil {
+		q := u.Query()
+		q.Set("id", strconv.Itoa(existing.controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	portForwards, err := pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return portForwards.GetByID(id)
+}
+
+func (pf *Client) CreateFirewallNATPortForward(ctx context.Context, portForwardReq FirewallNATPortForward) (*FirewallNATPortForward, error) {
+	pf.mutexes.FirewallNATPortForward.Lock()
+	defer pf.mutexes.FirewallNATPortForward.Unlock()
+
+	portForward, err := pf.createOrUpdateFirewallNATPortForward(ctx, portForwardReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forward, %w", ErrCreateOperationFailed, err)
+	}
+
+	return portForward, nil
+}
+
+func (pf *Client) UpdateFirewallNATPortForward(ctx context.Context, portForwardReq FirewallNATPortForward) (*FirewallNATPortForward, error) {
+	pf.mutexes.FirewallNATPortForward.Lock()
+	defer pf.mutexes.FirewallNATPortForward.Unlock()
+
+	portForwards, err := pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := portForwards.GetByID(portForwardReq.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	portForward, err := pf.createOrUpdateFirewallNATPortForward(ctx, portForwardReq, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%w NAT port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return portForward, nil
+}
+
+func (pf *Client) DeleteFirewallNATPortForward(ctx context.Context, id string) error {
+	pf.mutexes.FirewallNATPortForward.Lock()
+	defer pf.mutexes.FirewallNATPortForward.Unlock()
+
+	portForwards, err := pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return fmt.Errorf("%w NAT port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := portForwards.GetControlIDByID(id)
+	if err != nil {
+		return fmt.Errorf("%w NAT port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w NAT port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w NAT port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	portForwards, err = pf.getFirewallNATPortForwards(ctx)
+	if err != nil {
+		return fmt.Errorf("%w NAT port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err = portForwards.GetByID(id); err == nil {
+		return fmt.Errorf("%w NAT port forward, '%s' still exists", ErrDeleteOperationFailed, id)
+	}
+
+	return nil
+}
@@ -13,6 +13,9 @@ var (
 	ErrReloadFirewallFilter = errors.New("failed to reload firewall filter")
 )
 
+// ReloadFirewallFilter queues a filter reload and clears the rule, alias, and NAT pending changes. The reload runs in the
+// background on pfSense, so the pending changes are cleared once it is queued, before it finishes, and a reload which later
+// fails is not reported as pending.
 func (pf *Client) ReloadFirewallFilter(ctx context.Context) error {
 	u := url.URL{Path: "status_filter_reload.php"}
 	v := url.Values{
@@ -21,11 +24,38 @@ func (pf *Client) ReloadFirewallFilter(ctx context.Context) error {
 
 	resp, err := pf.call(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return fmt.Errorf("%w, %w", ErrApplyDNSResolverChange, err)
+		return fmt.Errorf("%w, %w", ErrReloadFirewallFilter, err)
 	}
 
 	defer resp.Body.Close()
 	_, _ = io.Copy(io.Discard, resp.Body)
 
+	// the reload page does not clear pending changes like the apply buttons on the rules, alias, and NAT pages do.
+	var command string
+	for _, subsystem := range firewallFilterSubsystems {
+		command += fmt.Sprintf("clear_subsystem_dirty('%s');", subsystem)
+	}
+
+	_, err = pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrReloadFirewallFilter, err)
+	}
+
 	return nil
 }
+
+// IsFirewallFilterDirty reports whether rule, alias, or NAT changes are pending a filter reload.
+func (pf *Client) IsFirewallFilterDirty(ctx context.Context) (bool, error) {
+	for _, subsystem := range firewallFilterSubsystems {
+		dirty, err := pf.isSubsystemDirty(ctx, subsystem)
+		if err != nil {
+			return false, fmt.Errorf("%w firewall filter pending changes, %w", ErrGetOperationFailed, err)
+		}
+
+		if dirty {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
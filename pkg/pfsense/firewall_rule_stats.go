@@ -0,0 +1,143 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const firewallRuleLabelPrefix = "USER_RULE: "
+
+var (
+	firewallRuleStatsRuleRegex     = regexp.MustCompile(`^@(\d+)(?:\((\d+)\))?\s+(.*)$`)
+	firewallRuleStatsCountersRegex = regexp.MustCompile(`Evaluations:\s*(\d+)\s+Packets:\s*(\d+)\s+Bytes:\s*(\d+)\s+States:\s*(\d+)`)
+	firewallRuleStatsTrackerRegex  = regexp.MustCompile(`\bridentifier\s+(\d+)`)
+	firewallRuleStatsLabelRegex    = regexp.MustCompile(`\blabel\s+"([^"]*)"`)
+)
+
+type firewallRuleStatsResponse struct {
+	Rules        []string          `json:"rules"`
+	Descriptions map[string]string `json:"descriptions"`
+}
+
+// FirewallRuleStats is the counters of a loaded pf rule, one configured rule may load as several pf rules (for example IPv4 and IPv6).
+type FirewallRuleStats struct {
+	Number      int
+	Rule        string
+	Tracker     string
+	Label       string
+	Description string
+	Evaluations uint64
+	Packets     uint64
+	Bytes       uint64
+	States      uint64
+}
+
+type FirewallRulesStats []FirewallRuleStats
+
+func (stats FirewallRulesStats) GetByTracker(tracker string) FirewallRulesStats {
+	var filtered FirewallRulesStats
+	for _, s := range stats {
+		if s.Tracker == tracker {
+			filtered = append(filtered, s)
+		}
+	}
+
+	return filtered
+}
+
+// ParseFirewallRuleStats parses the output of 'pfctl -vvsr', a rule line prefixed with its number and tracker ID (as in '@5(1000000103)')
+// followed by indented counter lines. Rules printed without a tracker ID fall back to the 'ridentifier' keyword of the rule.
+func ParseFirewallRuleStats(lines []string) (FirewallRulesStats, error) {
+	var stats FirewallRulesStats
+	var current *FirewallRuleStats
+
+	for _, line := range lines {
+		if match := firewallRuleStatsRuleRegex.FindStringSubmatch(line); match != nil {
+			if current != nil {
+				stats = append(stats, *current)
+			}
+
+			number, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("%w rule number '%s'", ErrUnableToParse, match[1])
+			}
+
+			current = &FirewallRuleStats{Number: number, Rule: match[3]}
+
+			if match[2] != "" && match[2] != "0" {
+				current.Tracker = match[2]
+			} else if m := firewallRuleStatsTrackerRegex.FindStringSubmatch(match[3]); m != nil {
+				current.Tracker = m[1]
+			}
+
+			if m := firewallRuleStatsLabelRegex.FindStringSubmatch(match[3]); m != nil {
+				current.Label = m[1]
+			}
+
+			continue
+		}
+
+		match := firewallRuleStatsCountersRegex.FindStringSubmatch(line)
+		if match == nil || current == nil {
+			continue
+		}
+
+		counters := make([]uint64, 4)
+		for i := range counters {
+			c, err := strconv.ParseUint(match[i+1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w rule counter '%s'", ErrUnableToParse, match[i+1])
+			}
+
+			counters[i] = c
+		}
+
+		current.Evaluations, current.Packets, current.Bytes, current.States = counters[0], counters[1], counters[2], counters[3]
+	}
+
+	if current != nil {
+		stats = append(stats, *current)
+	}
+
+	return stats, nil
+}
+
+// GetFirewallRuleStats returns the counters of all loaded pf rules, correlated with the description of the configured rule by tracker ID.
+func (pf *Client) GetFirewallRuleStats(ctx context.Context) (*FirewallRulesStats, error) {
+	command := "exec('/sbin/pfctl -vvsr 2>/dev/null', $rules);" +
+		"$descriptions = array();" +
+		"foreach ($config['filter']['rule'] ?? array() as $rule) {" +
+		"if (!empty($rule['tracker'])) { $descriptions[$rule['tracker']] = $rule['descr'] ?? ''; }" +
+		"};" +
+		"print_r(json_encode(array('rules' => $rules, 'descriptions' => (object) $descriptions)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule stats, %w", ErrGetOperationFailed, err)
+	}
+
+	var statsResp firewallRuleStatsResponse
+	err = json.Unmarshal(b, &statsResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule stats, %w, %w", ErrGetOperationFailed, ErrUnableToParse, err)
+	}
+
+	stats, err := ParseFirewallRuleStats(statsResp.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule stats, %w", ErrGetOperationFailed, err)
+	}
+
+	for i := range stats {
+		if description, ok := statsResp.Descriptions[stats[i].Tracker]; ok {
+			stats[i].Description = description
+		} else {
+			stats[i].Description = strings.TrimPrefix(stats[i].Label, firewallRuleLabelPrefix)
+		}
+	}
+
+	return &stats, nil
+}
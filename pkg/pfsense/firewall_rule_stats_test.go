@@ -0,0 +1,59 @@
+package pfsense
+
+import (
+	"strings"
+	"testing"
+)
+
+// output of 'pfctl -vvsr' on pfSense, trimmed to a few rules.
+const firewallRuleStatsFixture = `@0(0) scrub on vtnet0 all fragment reassemble
+  [ Evaluations: 10452     Packets: 5213      Bytes: 0           States: 0     ]
+  [ Inserted: uid 0 pid 45518 State Creations: 0     ]
+@4(1000000103) block drop in log inet all label "Default deny rule IPv4" ridentifier 1000000103
+  [ Evaluations: 2107      Packets: 311       Bytes: 18660       States: 0     ]
+  [ Inserted: uid 0 pid 45518 State Creations: 0     ]
+@5(1000000104) block drop in log inet6 all label "Default deny rule IPv6" ridentifier 1000000104
+  [ Evaluations: 1796      Packets: 42        Bytes: 3360        States: 0     ]
+  [ Inserted: uid 0 pid 45518 State Creations: 0     ]
+@87(1700000123) pass in quick on vtnet1 inet proto tcp from 192.0.2.0/24 to any port = ssh flags S/SA keep state label "USER_RULE: Allow SSH" ridentifier 1700000123
+  [ Evaluations: 964       Packets: 12842     Bytes: 2918254     States: 3     ]
+  [ Inserted: uid 0 pid 45518 State Creations: 17    ]
+@88 pass in quick on vtnet1 inet6 all keep state label "USER_RULE: Allow IPv6" ridentifier 1700000124
+  [ Evaluations: 310       Packets: 0         Bytes: 0           States: 0     ]
+  [ Inserted: uid 0 pid 45518 State Creations: 0     ]`
+
+func TestParseFirewallRuleStats(t *testing.T) {
+	stats, err := ParseFirewallRuleStats(strings.Split(firewallRuleStatsFixture, "\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := FirewallRulesStats{
+		{Number: 0, Evaluations: 10452, Packets: 5213},
+		{Number: 4, Tracker: "1000000103", Label: "Default deny rule IPv4", Evaluations: 2107, Packets: 311, Bytes: 18660},
+		{Number: 5, Tracker: "1000000104", Label: "Default deny rule IPv6", Evaluations: 1796, Packets: 42, Bytes: 3360},
+		{Number: 87, Tracker: "1700000123", Label: "USER_RULE: Allow SSH", Evaluations: 964, Packets: 12842, Bytes: 2918254, States: 3},
+		{Number: 88, Tracker: "1700000124", Label: "USER_RULE: Allow IPv6", Evaluations: 310},
+	}
+
+	if len(stats) != len(want) {
+		t.Fatalf("expected %d rules, got %d", len(want), len(stats))
+	}
+
+	for i := range want {
+		got := stats[i]
+		got.Rule = ""
+
+		if got != want[i] {
+			t.Errorf("rule %d: expected %+v, got %+v", i, want[i], got)
+		}
+	}
+
+	if !strings.HasPrefix(stats[3].Rule, "pass in quick on vtnet1 inet proto tcp") {
+		t.Errorf("rule 3: unexpected rule text '%s'", stats[3].Rule)
+	}
+
+	if filtered := stats.GetByTracker("1700000123"); len(filtered) != 1 || filtered[0].Number != 87 {
+		t.Errorf("expected rule 87 for tracker 1700000123, got %+v", filtered)
+	}
+}
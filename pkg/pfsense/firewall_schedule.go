@@ -0,0 +1,566 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	firewallScheduleMaxTimeRanges = 99
+	firewallScheduleTimeLayout    = "15:04"
+)
+
+var (
+	scheduleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]{1,31}$`)
+	scheduleTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+	scheduleDateRegex = regexp.MustCompile(`^([0-9]{2})-([0-9]{2})$`)
+)
+
+// FirewallScheduleWeekdays are ordered by the position pfSense uses for them (monday is 1).
+var FirewallScheduleWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+type firewallScheduleTimeRangeResponse struct {
+	Position         string `json:"position"`
+	Month            string `json:"month"`
+	Day              string `json:"day"`
+	Hour             string `json:"hour"`
+	RangeDescription string `json:"rangedescr"`
+}
+
+type firewallScheduleResponse struct {
+	Name        string                              `json:"name"`
+	Description string                              `json:"descr"`
+	TimeRanges  []firewallScheduleTimeRangeResponse `json:"timerange"`
+	Active      bool                                `json:"active"`
+	ControlID   int                                 `json:"controlID"`
+}
+
+type FirewallScheduleDate struct {
+	Month time.Month
+	Day   int
+}
+
+func (date FirewallScheduleDate) String() string {
+	return fmt.Sprintf("%02d-%02d", date.Month, date.Day)
+}
+
+type FirewallScheduleTimeRange struct {
+	Weekdays    []string
+	Dates       []FirewallScheduleDate
+	StartTime   string
+	StopTime    string
+	Description string
+}
+
+type FirewallSchedule struct {
+	Name        string
+	Description string
+	TimeRanges  []FirewallScheduleTimeRange
+	Active      bool
+	controlID   int
+}
+
+func parseFirewallScheduleTime(t string) (time.Time, error) {
+	if !scheduleTimeRegex.MatchString(t) {
+		return time.Time{}, fmt.Errorf("%w, time '%s' must be in 24 hour 'HH:MM' format", ErrClientValidation, t)
+	}
+
+	return time.Parse(firewallScheduleTimeLayout, t)
+}
+
+// formatFirewallScheduleTime converts the 'H:MM' format stored by pfSense to 'HH:MM'.
+func formatFirewallScheduleTime(t string) (string, error) {
+	hour, minute, found := strings.Cut(t, ":")
+	if !found {
+		return "", fmt.Errorf("%w schedule time '%s'", ErrUnableToParse, t)
+	}
+
+	h, err := strconv.Atoi(hour)
+	if err != nil {
+		return "", fmt.Errorf("%w schedule time '%s'", ErrUnableToParse, t)
+	}
+
+	m, err := strconv.Atoi(minute)
+	if err != nil {
+		return "", fmt.Errorf("%w schedule time '%s'", ErrUnableToParse, t)
+	}
+
+	return fmt.Sprintf("%02d:%02d", h, m), nil
+}
+
+func (schedule *FirewallSchedule) SetName(name string) error {
+	if !scheduleNameRegex.MatchString(name) {
+		return fmt.Errorf("%w, name must be 1-31 characters and only contain letters, numbers, and underscores", ErrClientValidation)
+	}
+
+	schedule.Name = name
+
+	return nil
+}
+
+func (schedule *FirewallSchedule) SetDescription(description string) error {
+	schedule.Description = description
+
+	return nil
+}
+
+func (timeRange *FirewallScheduleTimeRange) SetWeekdays(weekdays []string) error {
+	positions := map[string]int{}
+	for i, weekday := range FirewallScheduleWeekdays {
+		positions[weekday] = i
+	}
+
+	seen := map[string]bool{}
+	for _, weekday := range weekdays {
+		if _, ok := positions[weekday]; !ok {
+			return fmt.Errorf("%w, day of week '%s' must be one of '%s'", ErrClientValidation, weekday, strings.Join(FirewallScheduleWeekdays, "', '"))
+		}
+
+		if seen[weekday] {
+			return fmt.Errorf("%w, day of week '%s' listed more than once", ErrClientValidation, weekday)
+		}
+
+		seen[weekday] = true
+	}
+
+	sorted := append([]string{}, weekdays...)
+	sort.Slice(sorted, func(i, j int) bool { return positions[sorted[i]] < positions[sorted[j]] })
+	timeRange.Weekdays = sorted
+
+	return nil
+}
+
+func (timeRange *FirewallScheduleTimeRange) SetDates(dates []string) error {
+	seen := map[string]bool{}
+	var parsed []FirewallScheduleDate
+
+	for _, date := range dates {
+		match := scheduleDateRegex.FindStringSubmatch(date)
+		if match == nil {
+			return fmt.Errorf("%w, date '%s' must be in 'MM-DD' format", ErrClientValidation, date)
+		}
+
+		month, _ := strconv.Atoi(match[1])
+		day, _ := strconv.Atoi(match[2])
+
+		// a leap year is used so that february 29th is accepted
+		if month < 1 || month > 12 || day < 1 || day > time.Date(2024, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+			return fmt.Errorf("%w, date '%s' is not a valid month and day", ErrClientValidation, date)
+		}
+
+		if seen[date] {
+			return fmt.Errorf("%w, date '%s' listed more than once", ErrClientValidation, date)
+		}
+
+		seen[date] = true
+		parsed = append(parsed, FirewallScheduleDate{Month: time.Month(month), Day: day})
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		if parsed[i].Month != parsed[j].Month {
+			return parsed[i].Month < parsed[j].Month
+		}
+		return parsed[i].Day < parsed[j].Day
+	})
+	timeRange.Dates = parsed
+
+	return nil
+}
+
+func (timeRange *FirewallScheduleTimeRange) SetStartTime(t string) error {
+	if _, err := parseFirewallScheduleTime(t); err != nil {
+		return err
+	}
+
+	timeRange.StartTime = t
+
+	return nil
+}
+
+func (timeRange *FirewallScheduleTimeRange) SetStopTime(t string) error {
+	if _, err := parseFirewallScheduleTime(t); err != nil {
+		return err
+	}
+
+	timeRange.StopTime = t
+
+	return nil
+}
+
+func (timeRange *FirewallScheduleTimeRange) SetDescription(description string) error {
+	timeRange.Description = description
+
+	return nil
+}
+
+// Validate checks that exactly one of weekdays or dates is set and that the range ends after it starts.
+func (timeRange FirewallScheduleTimeRange) Validate() error {
+	if len(timeRange.Weekdays) == 0 && len(timeRange.Dates) == 0 {
+		return fmt.Errorf("%w, time range requires days of week or dates", ErrClientValidation)
+	}
+
+	if len(timeRange.Weekdays) != 0 && len(timeRange.Dates) != 0 {
+		return fmt.Errorf("%w, time range cannot use both days of week and dates", ErrClientValidation)
+	}
+
+	start, err := parseFirewallScheduleTime(timeRange.StartTime)
+	if err != nil {
+		return err
+	}
+
+	stop, err := parseFirewallScheduleTime(timeRange.StopTime)
+	if err != nil {
+		return err
+	}
+
+	if !stop.After(start) {
+		return fmt.Errorf("%w, stop time '%s' must be after start time '%s'", ErrClientValidation, timeRange.StopTime, timeRange.StartTime)
+	}
+
+	return nil
+}
+
+func (timeRange FirewallScheduleTimeRange) formatSchedule() string {
+	var parts []string
+
+	if len(timeRange.Weekdays) != 0 {
+		for _, weekday := range timeRange.Weekdays {
+			for i, w := range FirewallScheduleWeekdays {
+				if w == weekday {
+					parts = append(parts, strconv.Itoa(i+1))
+				}
+			}
+		}
+
+		return strings.Join(parts, ",")
+	}
+
+	for _, date := range timeRange.Dates {
+		parts = append(parts, fmt.Sprintf("w0p0-m%dd%d", date.Month, date.Day))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (schedule FirewallSchedule) Validate() error {
+	if len(schedule.TimeRanges) == 0 {
+		return fmt.Errorf("%w, schedule requires at least one time range", ErrClientValidation)
+	}
+
+	if len(schedule.TimeRanges) > firewallScheduleMaxTimeRanges {
+		return fmt.Errorf("%w, schedule cannot have more than %d time ranges", ErrClientValidation, firewallScheduleMaxTimeRanges)
+	}
+
+	for i, timeRange := range schedule.TimeRanges {
+		if err := timeRange.Validate(); err != nil {
+			return fmt.Errorf("time range %d, %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+type FirewallSchedules []FirewallSchedule
+
+func (schedules FirewallSchedules) GetByName(name string) (*FirewallSchedule, error) {
+	for _, s := range schedules {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall schedule %w with name '%s'", ErrNotFound, name)
+}
+
+func (schedules FirewallSchedules) GetControlIDByName(name string) (*int, error) {
+	for _, s := range schedules {
+		if s.Name == name {
+			return &s.controlID, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall schedule %w with name '%s'", ErrNotFound, name)
+}
+
+func parseFirewallScheduleTimeRange(resp firewallScheduleTimeRangeResponse) (*FirewallScheduleTimeRange, error) {
+	var timeRange FirewallScheduleTimeRange
+
+	if resp.Month != "" {
+		months := strings.Split(resp.Month, ",")
+		days := strings.Split(resp.Day, ",")
+		if len(months) != len(days) {
+			return nil, fmt.Errorf("%w schedule time range, month and day lists differ in length", ErrUnableToParse)
+		}
+
+		var dates []string
+		for i := range months {
+			month, err := strconv.Atoi(months[i])
+			if err != nil {
+				return nil, fmt.Errorf("%w schedule time range month '%s'", ErrUnableToParse, months[i])
+			}
+
+			day, err := strconv.Atoi(days[i])
+			if err != nil {
+				return nil, fmt.Errorf("%w schedule time range day '%s'", ErrUnableToParse, days[i])
+			}
+
+			dates = append(dates, FirewallScheduleDate{Month: time.Month(month), Day: day}.String())
+		}
+
+		if err := timeRange.SetDates(dates); err != nil {
+			return nil, err
+		}
+	} else {
+		var weekdays []string
+		for _, position := range removeEmptyStrings(strings.Split(resp.Position, ",")) {
+			p, err := strconv.Atoi(position)
+			if err != nil || p < 1 || p > len(FirewallScheduleWeekdays) {
+				return nil, fmt.Errorf("%w schedule time range day of week '%s'", ErrUnableToParse, position)
+			}
+
+			weekdays = append(weekdays, FirewallScheduleWeekdays[p-1])
+		}
+
+		if err := timeRange.SetWeekdays(weekdays); err != nil {
+			return nil, err
+		}
+	}
+
+	start, stop, found := strings.Cut(resp.Hour, "-")
+	if !found {
+		return nil, fmt.Errorf("%w schedule time range hours '%s'", ErrUnableToParse, resp.Hour)
+	}
+
+	start, err := formatFirewallScheduleTime(start)
+	if err != nil {
+		return nil, err
+	}
+
+	stop, err = formatFirewallScheduleTime(stop)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := timeRange.SetStartTime(start); err != nil {
+		return nil, err
+	}
+
+	if err := timeRange.SetStopTime(stop); err != nil {
+		return nil, err
+	}
+
+	if err := timeRange.SetDescription(html.UnescapeString(resp.RangeDescription)); err != nil {
+		return nil, err
+	}
+
+	return &timeRange, nil
+}
+
+func (pf *Client) getFirewallSchedules(ctx context.Context) (*FirewallSchedules, error) {
+	command := "require_once('filter.inc');" +
+		"$output = array();" +
+		"foreach ($config['schedules']['schedule'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; $v['active'] = filter_get_time_based_rule_status($v); array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduleResp []firewallScheduleResponse
+	err = json.Unmarshal(b, &scheduleResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var schedules FirewallSchedules
+	for _, resp := range scheduleResp {
+		var schedule FirewallSchedule
+		var err error
+
+		schedule.Active = resp.Active
+		schedule.controlID = resp.ControlID
+
+		err = schedule.SetName(resp.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+		}
+
+		err = schedule.SetDescription(html.UnescapeString(resp.Description))
+		if err != nil {
+			return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+		}
+
+		for _, timeRangeResp := range resp.TimeRanges {
+			timeRange, err := parseFirewallScheduleTimeRange(timeRangeResp)
+			if err != nil {
+				return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+			}
+
+			schedule.TimeRanges = append(schedule.TimeRanges, *timeRange)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return &schedules, nil
+}
+
+func (pf *Client) GetFirewallSchedules(ctx context.Context) (*FirewallSchedules, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedules, %w", ErrGetOperationFailed, err)
+	}
+
+	return schedules, nil
+}
+
+func (pf *Client) GetFirewallSchedule(ctx context.Context, name string) (*FirewallSchedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedule (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return schedules.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateFirewallSchedule(ctx context.Context, scheduleReq FirewallSchedule, controlID *int) (*FirewallSchedule, error) {
+	err := scheduleReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "firewall_schedule_edit.php"}
+	v := url.Values{
+		"name":  {scheduleReq.Name},
+		"descr": {scheduleReq.Description},
+		"save":  {"Save"},
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	for i, timeRange := range scheduleReq.TimeRanges {
+		v.Set(fmt.Sprintf("schedule%d", i), timeRange.formatSchedule())
+		v.Set(fmt.Sprintf("starttime%d", i), timeRange.StartTime)
+		v.Set(fmt.Sprintf("stoptime%d", i), timeRange.StopTime)
+		v.Set(fmt.Sprintf("timedescr%d", i), timeRange.Description)
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := schedules.GetByName(scheduleReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (pf *Client) CreateFirewallSchedule(ctx context.Context, scheduleReq FirewallSchedule) (*FirewallSchedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedule, err := pf.createOrUpdateFirewallSchedule(ctx, scheduleReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedule, %w", ErrCreateOperationFailed, err)
+	}
+
+	return schedule, nil
+}
+
+func (pf *Client) UpdateFirewallSchedule(ctx context.Context, scheduleReq FirewallSchedule) (*FirewallSchedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := schedules.GetControlIDByName(scheduleReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	schedule, err := pf.createOrUpdateFirewallSchedule(ctx, scheduleReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return schedule, nil
+}
+
+func (pf *Client) DeleteFirewallSchedule(ctx context.Context, name string) error {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := schedules.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w firewall schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_schedule.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w firewall schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w firewall schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	schedules, err = pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete schedules referenced by filter rules
+	if _, err = schedules.GetByName(name); err == nil {
+		return fmt.Errorf("%w firewall schedule, '%s' still exists, it may be in use by a filter rule", ErrDeleteOperationFailed, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,974 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+const (
+	FirewallShaperSchedulerPRIQ   = "PRIQ"
+	FirewallShaperSchedulerHFSC   = "HFSC"
+	FirewallShaperSchedulerCBQ    = "CBQ"
+	FirewallShaperSchedulerFAIRQ  = "FAIRQ"
+	FirewallShaperSchedulerCODELQ = "CODELQ"
+
+	FirewallShaperBandwidthUnitPercent = "%"
+)
+
+const (
+	firewallShaperEnabled = "on"
+	firewallShaperFlag    = "yes"
+)
+
+var (
+	shaperQueueNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,15}$`)
+)
+
+var FirewallShaperSchedulers = []string{
+	FirewallShaperSchedulerPRIQ,
+	FirewallShaperSchedulerHFSC,
+	FirewallShaperSchedulerCBQ,
+	FirewallShaperSchedulerFAIRQ,
+	FirewallShaperSchedulerCODELQ,
+}
+
+var FirewallShaperBandwidthUnits = []string{"b", "Kb", "Mb", "Gb", FirewallShaperBandwidthUnitPercent}
+
+var firewallShaperBandwidthMultipliers = map[string]float64{
+	"b":  1,
+	"Kb": 1e3,
+	"Mb": 1e6,
+	"Gb": 1e9,
+}
+
+type firewallShaperQueueResponse struct {
+	Name          string                        `json:"name"`
+	Priority      string                        `json:"priority"`
+	Bandwidth     string                        `json:"bandwidth"`
+	BandwidthUnit string                        `json:"bandwidthtype"`
+	QueueLength   string                        `json:"qlimit"`
+	Description   string                        `json:"description"`
+	Enabled       string                        `json:"enabled"`
+	Default       string                        `json:"default"`
+	RED           string                        `json:"red"`
+	RIO           string                        `json:"rio"`
+	ECN           string                        `json:"ecn"`
+	CoDel         string                        `json:"codel"`
+	Queues        []firewallShaperQueueResponse `json:"queue"`
+}
+
+type firewallShaperResponse struct {
+	Interface     string                        `json:"interface"`
+	Scheduler     string                        `json:"scheduler"`
+	Bandwidth     string                        `json:"bandwidth"`
+	BandwidthUnit string                        `json:"bandwidthtype"`
+	QueueLength   string                        `json:"qlimit"`
+	TBRSize       string                        `json:"tbrconfig"`
+	Enabled       string                        `json:"enabled"`
+	Queues        []firewallShaperQueueResponse `json:"queue"`
+}
+
+type FirewallShaperBandwidth struct {
+	Bandwidth int
+	Unit      string
+}
+
+type FirewallShaper struct {
+	Interface   string
+	Scheduler   string
+	Bandwidth   *FirewallShaperBandwidth
+	QueueLength *int
+	TBRSize     *int
+	Enabled     bool
+	Queues      []FirewallShaperQueue
+}
+
+type FirewallShaperQueue struct {
+	Interface   string
+	Name        string
+	Parent      string
+	Description string
+	Priority    *int
+	Bandwidth   *FirewallShaperBandwidth
+	QueueLength *int
+	Enabled     bool
+	Default     bool
+	RED         bool
+	RIO         bool
+	ECN         bool
+	CoDel       bool
+}
+
+func (bandwidth *FirewallShaperBandwidth) SetBandwidth(b int) error {
+	if b < 1 {
+		return fmt.Errorf("%w, bandwidth must be greater than zero", ErrClientValidation)
+	}
+
+	bandwidth.Bandwidth = b
+
+	return nil
+}
+
+func (bandwidth *FirewallShaperBandwidth) SetUnit(unit string) error {
+	err := validateChoice("bandwidth unit", unit, FirewallShaperBandwidthUnits)
+	if err != nil {
+		return err
+	}
+
+	bandwidth.Unit = unit
+
+	return nil
+}
+
+func (bandwidth FirewallShaperBandwidth) Validate() error {
+	if bandwidth.Unit == FirewallShaperBandwidthUnitPercent && bandwidth.Bandwidth > 100 {
+		return fmt.Errorf("%w, bandwidth percentage must not exceed 100", ErrClientValidation)
+	}
+
+	return nil
+}
+
+// false when the share cannot be known without the interface speed.
+func (bandwidth FirewallShaperBandwidth) share(parent *FirewallShaperBandwidth) (float64, bool) {
+	if bandwidth.Unit == FirewallShaperBandwidthUnitPercent {
+		return float64(bandwidth.Bandwidth) / 100, true
+	}
+
+	if parent == nil || parent.Unit == FirewallShaperBandwidthUnitPercent {
+		return 0, false
+	}
+
+	return float64(bandwidth.Bandwidth) * firewallShaperBandwidthMultipliers[bandwidth.Unit] /
+		(float64(parent.Bandwidth) * firewallShaperBandwidthMultipliers[parent.Unit]), true
+}
+
+func parseFirewallShaperBandwidth(b string, unit string) (*FirewallShaperBandwidth, error) {
+	if b == "" {
+		return nil, nil
+	}
+
+	var bandwidth FirewallShaperBandwidth
+
+	i, err := strconv.Atoi(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w shaper bandwidth '%s'", ErrUnableToParse, b)
+	}
+
+	err = bandwidth.SetBandwidth(i)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bandwidth.SetUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bandwidth, nil
+}
+
+func (shaper *FirewallShaper) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	shaper.Interface = iface
+
+	return nil
+}
+
+func (shaper *FirewallShaper) SetScheduler(scheduler string) error {
+	err := validateChoice("scheduler", scheduler, FirewallShaperSchedulers)
+	if err != nil {
+		return err
+	}
+
+	shaper.Scheduler = scheduler
+
+	return nil
+}
+
+func (shaper *FirewallShaper) SetBandwidth(bandwidth FirewallShaperBandwidth) error {
+	err := bandwidth.Validate()
+	if err != nil {
+		return err
+	}
+
+	shaper.Bandwidth = &bandwidth
+
+	return nil
+}
+
+func (shaper *FirewallShaper) SetQueueLength(length int) error {
+	if length < 1 {
+		return fmt.Errorf("%w, queue length must be greater than zero", ErrClientValidation)
+	}
+
+	shaper.QueueLength = &length
+
+	return nil
+}
+
+func (shaper *FirewallShaper) SetTBRSize(size int) error {
+	if size < 1 {
+		return fmt.Errorf("%w, token bucket size must be greater than zero", ErrClientValidation)
+	}
+
+	shaper.TBRSize = &size
+
+	return nil
+}
+
+func (shaper *FirewallShaper) SetEnabled(enabled bool) error {
+	shaper.Enabled = enabled
+
+	return nil
+}
+
+func (shaper FirewallShaper) children(parent string) []FirewallShaperQueue {
+	var children []FirewallShaperQueue
+	for _, q := range shaper.Queues {
+		if q.Parent == parent {
+			children = append(children, q)
+		}
+	}
+
+	return children
+}
+
+func (shaper FirewallShaper) GetQueueByName(name string) (*FirewallShaperQueue, error) {
+	for _, q := range shaper.Queues {
+		if q.Name == name {
+			return &q, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall shaper queue %w with name '%s' on interface '%s'", ErrNotFound, name, shaper.Interface)
+}
+
+// absolute child bandwidths under a percentage or unset parent need the interface speed, those are left to pfSense.
+func (shaper FirewallShaper) validateBandwidths() error {
+	parents := map[string]*FirewallShaperBandwidth{"": shaper.Bandwidth}
+	for _, q := range shaper.Queues {
+		parents[q.Name] = q.Bandwidth
+	}
+
+	for parent, parentBandwidth := range parents {
+		var total float64
+		for _, child := range shaper.children(parent) {
+			if child.Bandwidth == nil {
+				continue
+			}
+
+			share, ok := child.Bandwidth.share(parentBandwidth)
+			if !ok {
+				continue
+			}
+
+			total += share
+		}
+
+		// allow for floating point error when percentages add up to exactly 100
+		if total > 1+1e-9 {
+			if parent == "" {
+				return fmt.Errorf("%w, queue bandwidths on interface '%s' exceed the interface bandwidth", ErrClientValidation, shaper.Interface)
+			}
+
+			return fmt.Errorf("%w, child queue bandwidths exceed the bandwidth of queue '%s'", ErrClientValidation, parent)
+		}
+	}
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	queue.Interface = iface
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetName(name string) error {
+	if !shaperQueueNameRegex.MatchString(name) {
+		return fmt.Errorf("%w, name must be 1-15 characters and only contain letters, numbers, underscores, and hyphens", ErrClientValidation)
+	}
+
+	queue.Name = name
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetParent(parent string) error {
+	if parent != "" && !shaperQueueNameRegex.MatchString(parent) {
+		return fmt.Errorf("%w, parent '%s' is not a valid queue name", ErrClientValidation, parent)
+	}
+
+	queue.Parent = parent
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetDescription(description string) error {
+	queue.Description = description
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetPriority(priority int) error {
+	if priority < 0 || priority > 15 {
+		return fmt.Errorf("%w, priority must be between 0 and 15", ErrClientValidation)
+	}
+
+	queue.Priority = &priority
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetBandwidth(bandwidth FirewallShaperBandwidth) error {
+	err := bandwidth.Validate()
+	if err != nil {
+		return err
+	}
+
+	queue.Bandwidth = &bandwidth
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetQueueLength(length int) error {
+	if length < 1 {
+		return fmt.Errorf("%w, queue length must be greater than zero", ErrClientValidation)
+	}
+
+	queue.QueueLength = &length
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetEnabled(enabled bool) error {
+	queue.Enabled = enabled
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetDefault(d bool) error {
+	queue.Default = d
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetRED(red bool) error {
+	queue.RED = red
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetRIO(rio bool) error {
+	queue.RIO = rio
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetECN(ecn bool) error {
+	queue.ECN = ecn
+
+	return nil
+}
+
+func (queue *FirewallShaperQueue) SetCoDel(codel bool) error {
+	queue.CoDel = codel
+
+	return nil
+}
+
+// Validate checks the queue against the scheduler of the interface it is attached to.
+func (queue FirewallShaperQueue) Validate(shaper FirewallShaper) error {
+	if queue.Name == queue.Parent {
+		return fmt.Errorf("%w, queue cannot be its own parent", ErrClientValidation)
+	}
+
+	switch shaper.Scheduler {
+	case FirewallShaperSchedulerCODELQ:
+		return fmt.Errorf("%w, '%s' scheduler does not support queues", ErrClientValidation, FirewallShaperSchedulerCODELQ)
+	case FirewallShaperSchedulerPRIQ, FirewallShaperSchedulerFAIRQ:
+		if queue.Parent != "" {
+			return fmt.Errorf("%w, '%s' scheduler does not support nested queues", ErrClientValidation, shaper.Scheduler)
+		}
+	case FirewallShaperSchedulerCBQ:
+		if queue.Priority != nil && *queue.Priority > 7 {
+			return fmt.Errorf("%w, '%s' priority must be between 0 and 7", ErrClientValidation, FirewallShaperSchedulerCBQ)
+		}
+	}
+
+	if shaper.Scheduler == FirewallShaperSchedulerPRIQ && queue.Bandwidth != nil {
+		return fmt.Errorf("%w, '%s' queues are not assigned bandwidth", ErrClientValidation, FirewallShaperSchedulerPRIQ)
+	}
+
+	if queue.Parent != "" {
+		if _, err := shaper.GetQueueByName(queue.Parent); err != nil {
+			return err
+		}
+	}
+
+	if queue.ECN && !queue.RED && !queue.RIO {
+		return fmt.Errorf("%w, ECN requires RED or RIO", ErrClientValidation)
+	}
+
+	if queue.Default {
+		for _, q := range shaper.Queues {
+			if q.Default && q.Name != queue.Name {
+				return fmt.Errorf("%w, queue '%s' is already the default queue on interface '%s'", ErrClientValidation, q.Name, shaper.Interface)
+			}
+		}
+
+		if len(shaper.children(queue.Name)) != 0 {
+			return fmt.Errorf("%w, default queue cannot have child queues", ErrClientValidation)
+		}
+	}
+
+	return nil
+}
+
+type FirewallShapers []FirewallShaper
+
+func (shapers FirewallShapers) GetByInterface(iface string) (*FirewallShaper, error) {
+	for _, s := range shapers {
+		if s.Interface == iface {
+			return &s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall shaper %w for interface '%s'", ErrNotFound, iface)
+}
+
+func (shapers FirewallShapers) GetQueue(iface string, name string) (*FirewallShaperQueue, error) {
+	shaper, err := shapers.GetByInterface(iface)
+	if err != nil {
+		return nil, err
+	}
+
+	return shaper.GetQueueByName(name)
+}
+
+func (shaper FirewallShaper) withQueue(queue FirewallShaperQueue) FirewallShaper {
+	queues := []FirewallShaperQueue{}
+	for _, q := range shaper.Queues {
+		if q.Name != queue.Name {
+			queues = append(queues, q)
+		}
+	}
+
+	shaper.Queues = append(queues, queue)
+
+	return shaper
+}
+
+func parseFirewallShaperQueues(iface string, parent string, resps []firewallShaperQueueResponse) ([]FirewallShaperQueue, error) {
+	var queues []FirewallShaperQueue
+
+	for _, resp := range resps {
+		var queue FirewallShaperQueue
+		var err error
+
+		queue.Enabled = resp.Enabled == firewallShaperEnabled
+		queue.Default = resp.Default != ""
+		queue.RED = resp.RED != ""
+		queue.RIO = resp.RIO != ""
+		queue.ECN = resp.ECN != ""
+		queue.CoDel = resp.CoDel != ""
+
+		err = queue.SetInterface(iface)
+		if err != nil {
+			return nil, err
+		}
+
+		err = queue.SetName(resp.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = queue.SetParent(parent)
+		if err != nil {
+			return nil, err
+		}
+
+		err = queue.SetDescription(html.UnescapeString(resp.Description))
+		if err != nil {
+			return nil, err
+		}
+
+		priority, err := parseOptionalInt("shaper queue priority", resp.Priority)
+		if err != nil {
+			return nil, err
+		}
+
+		if priority != nil {
+			err = queue.SetPriority(*priority)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		bandwidth, err := parseFirewallShaperBandwidth(resp.Bandwidth, resp.BandwidthUnit)
+		if err != nil {
+			return nil, err
+		}
+
+		if bandwidth != nil {
+			err = queue.SetBandwidth(*bandwidth)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		queueLength, err := parseOptionalInt("shaper queue length", resp.QueueLength)
+		if err != nil {
+			return nil, err
+		}
+
+		if queueLength != nil {
+			err = queue.SetQueueLength(*queueLength)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		queues = append(queues, queue)
+
+		children, err := parseFirewallShaperQueues(iface, queue.Name, resp.Queues)
+		if err != nil {
+			return nil, err
+		}
+
+		queues = append(queues, children...)
+	}
+
+	return queues, nil
+}
+
+func parseFirewallShaper(resp firewallShaperResponse) (*FirewallShaper, error) {
+	var shaper FirewallShaper
+	var err error
+
+	shaper.Enabled = resp.Enabled == firewallShaperEnabled
+
+	err = shaper.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	err = shaper.SetScheduler(resp.Scheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidth, err := parseFirewallShaperBandwidth(resp.Bandwidth, resp.BandwidthUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	if bandwidth != nil {
+		err = shaper.SetBandwidth(*bandwidth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	queueLength, err := parseOptionalInt("shaper queue length", resp.QueueLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if queueLength != nil {
+		err = shaper.SetQueueLength(*queueLength)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tbrSize, err := parseOptionalInt("shaper token bucket size", resp.TBRSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if tbrSize != nil {
+		err = shaper.SetTBRSize(*tbrSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shaper.Queues, err = parseFirewallShaperQueues(shaper.Interface, "", resp.Queues)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shaper, nil
+}
+
+func (pf *Client) getFirewallShapers(ctx context.Context) (*FirewallShapers, error) {
+	b, err := pf.getConfigJSON(ctx, "['shaper']['queue']")
+	if err != nil {
+		return nil, err
+	}
+
+	var shaperResp []firewallShaperResponse
+	err = json.Unmarshal(b, &shaperResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var shapers FirewallShapers
+	for _, resp := range shaperResp {
+		shaper, err := parseFirewallShaper(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w shaper response, %w", ErrUnableToParse, err)
+		}
+
+		shapers = append(shapers, *shaper)
+	}
+
+	return &shapers, nil
+}
+
+func (pf *Client) postFirewallShaperForm(ctx context.Context, v url.Values) error {
+	u := url.URL{Path: "firewall_shaper.php"}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) GetFirewallShapers(ctx context.Context) (*FirewallShapers, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shapers, %w", ErrGetOperationFailed, err)
+	}
+
+	return shapers, nil
+}
+
+func (pf *Client) GetFirewallShaper(ctx context.Context, iface string) (*FirewallShaper, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper (interface '%s'), %w", ErrGetOperationFailed, iface, err)
+	}
+
+	return shapers.GetByInterface(iface)
+}
+
+func (pf *Client) createOrUpdateFirewallShaper(ctx context.Context, shaperReq FirewallShaper) (*FirewallShaper, error) {
+	v := url.Values{
+		"interface": {shaperReq.Interface},
+		"name":      {shaperReq.Interface},
+		"scheduler": {shaperReq.Scheduler},
+		"qlimit":    {formatOptionalInt(shaperReq.QueueLength)},
+		"tbrconfig": {formatOptionalInt(shaperReq.TBRSize)},
+		"save":      {"Save"},
+	}
+
+	if shaperReq.Bandwidth != nil {
+		v.Set("bandwidth", strconv.Itoa(shaperReq.Bandwidth.Bandwidth))
+		v.Set("bandwidthtype", shaperReq.Bandwidth.Unit)
+	}
+
+	if shaperReq.Enabled {
+		v.Set("enabled", firewallShaperEnabled)
+	}
+
+	err := pf.postFirewallShaperForm(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shaper, err := shapers.GetByInterface(shaperReq.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	return shaper, nil
+}
+
+func (pf *Client) CreateFirewallShaper(ctx context.Context, shaperReq FirewallShaper) (*FirewallShaper, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := shapers.GetByInterface(shaperReq.Interface); err == nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w, interface '%s' already has a shaper", ErrCreateOperationFailed, ErrClientValidation, shaperReq.Interface)
+	}
+
+	shaper, err := pf.createOrUpdateFirewallShaper(ctx, shaperReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrCreateOperationFailed, err)
+	}
+
+	return shaper, nil
+}
+
+func (pf *Client) UpdateFirewallShaper(ctx context.Context, shaperReq FirewallShaper) (*FirewallShaper, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := shapers.GetByInterface(shaperReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if existing.Scheduler != shaperReq.Scheduler && len(existing.Queues) != 0 {
+		return nil, fmt.Errorf("%w firewall shaper, %w, scheduler cannot be changed while the interface has queues", ErrUpdateOperationFailed, ErrClientValidation)
+	}
+
+	// the existing queues must still fit within the new interface bandwidth
+	shaperReq.Queues = existing.Queues
+	err = shaperReq.validateBandwidths()
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrUpdateOperationFailed, err)
+	}
+
+	shaper, err := pf.createOrUpdateFirewallShaper(ctx, shaperReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return shaper, nil
+}
+
+func (pf *Client) DeleteFirewallShaper(ctx context.Context, iface string) error {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := shapers.GetByInterface(iface); err != nil {
+		return fmt.Errorf("%w firewall shaper, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = pf.postFirewallShaperForm(ctx, url.Values{
+		"action":    {"delete"},
+		"interface": {iface},
+		"queue":     {iface},
+	})
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper, %w", ErrDeleteOperationFailed, err)
+	}
+
+	shapers, err = pf.getFirewallShapers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// removing the interface shaper removes its queues, pfSense refuses when a filter rule still references one of them
+	if _, err = shapers.GetByInterface(iface); err == nil {
+		return fmt.Errorf("%w firewall shaper, interface '%s' still has a shaper, its queues may be in use by a filter rule", ErrDeleteOperationFailed, iface)
+	}
+
+	return nil
+}
+
+func (pf *Client) GetFirewallShaperQueue(ctx context.Context, iface string, name string) (*FirewallShaperQueue, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue (interface '%s', name '%s'), %w", ErrGetOperationFailed, iface, name, err)
+	}
+
+	return shapers.GetQueue(iface, name)
+}
+
+func (pf *Client) createOrUpdateFirewallShaperQueue(ctx context.Context, queueReq FirewallShaperQueue, shaper FirewallShaper, create bool) (*FirewallShaperQueue, error) {
+	err := queueReq.Validate(shaper)
+	if err != nil {
+		return nil, err
+	}
+
+	err = shaper.withQueue(queueReq).validateBandwidths()
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{
+		"interface":   {queueReq.Interface},
+		"name":        {queueReq.Name},
+		"description": {queueReq.Description},
+		"priority":    {formatOptionalInt(queueReq.Priority)},
+		"qlimit":      {formatOptionalInt(queueReq.QueueLength)},
+		"save":        {"Save"},
+	}
+
+	if create {
+		parent := queueReq.Parent
+		if parent == "" {
+			parent = queueReq.Interface
+		}
+
+		v.Set("parentqueue", parent)
+	}
+
+	if queueReq.Bandwidth != nil {
+		v.Set("bandwidth", strconv.Itoa(queueReq.Bandwidth.Bandwidth))
+		v.Set("bandwidthtype", queueReq.Bandwidth.Unit)
+	}
+
+	for field, enabled := range map[string]bool{
+		"default": queueReq.Default,
+		"red":     queueReq.RED,
+		"rio":     queueReq.RIO,
+		"ecn":     queueReq.ECN,
+		"codel":   queueReq.CoDel,
+	} {
+		if enabled {
+			v.Set(field, firewallShaperFlag)
+		}
+	}
+
+	if queueReq.Enabled {
+		v.Set("enabled", firewallShaperEnabled)
+	}
+
+	err = pf.postFirewallShaperForm(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := shapers.GetQueue(queueReq.Interface, queueReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) CreateFirewallShaperQueue(ctx context.Context, queueReq FirewallShaperQueue) (*FirewallShaperQueue, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	shaper, err := shapers.GetByInterface(queueReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := shaper.GetQueueByName(queueReq.Name); err == nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w, queue '%s' already exists on interface '%s'", ErrCreateOperationFailed, ErrClientValidation, queueReq.Name, queueReq.Interface)
+	}
+
+	queue, err := pf.createOrUpdateFirewallShaperQueue(ctx, queueReq, *shaper, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrCreateOperationFailed, err)
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) UpdateFirewallShaperQueue(ctx context.Context, queueReq FirewallShaperQueue) (*FirewallShaperQueue, error) {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	shaper, err := shapers.GetByInterface(queueReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := shaper.GetQueueByName(queueReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if existing.Parent != queueReq.Parent {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w, queue '%s' cannot be moved to another parent", ErrUpdateOperationFailed, ErrClientValidation, queueReq.Name)
+	}
+
+	queue, err := pf.createOrUpdateFirewallShaperQueue(ctx, queueReq, *shaper, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall shaper queue, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return queue, nil
+}
+
+func (pf *Client) DeleteFirewallShaperQueue(ctx context.Context, iface string, name string) error {
+	pf.mutexes.FirewallShaper.Lock()
+	defer pf.mutexes.FirewallShaper.Unlock()
+
+	shapers, err := pf.getFirewallShapers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := shapers.GetQueue(iface, name); err != nil {
+		return fmt.Errorf("%w firewall shaper queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = pf.postFirewallShaperForm(ctx, url.Values{
+		"action":    {"delete"},
+		"interface": {iface},
+		"queue":     {name},
+	})
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	shapers, err = pf.getFirewallShapers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall shaper queue, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete queues referenced by filter rules
+	if _, err = shapers.GetQueue(iface, name); err == nil {
+		return fmt.Errorf("%w firewall shaper queue, '%s' still exists, it may be in use by a filter rule", ErrDeleteOperationFailed, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+var (
+	ErrApplyFirewallShaperChange = errors.New("failed to apply traffic shaper changes")
+)
+
+func (pf *Client) applyFirewallShaperChanges(ctx context.Context, page string) error {
+	pf.mutexes.FirewallShaperApply.Lock()
+	defer pf.mutexes.FirewallShaperApply.Unlock()
+
+	u := url.URL{Path: page}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyFirewallShaperChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+func (pf *Client) ApplyFirewallLimiterChanges(ctx context.Context) error {
+	return pf.applyFirewallShaperChanges(ctx, "firewall_shaper_vinterface.php")
+}
+
+func (pf *Client) ApplyFirewallShaperChanges(ctx context.Context) error {
+	return pf.applyFirewallShaperChanges(ctx, "firewall_shaper.php")
+}
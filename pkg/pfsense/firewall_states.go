@@ -0,0 +1,303 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrKillFirewallStates = errors.New("failed to kill firewall states")
+)
+
+type firewallStateResponse struct {
+	Interface           string `json:"interface"`
+	Protocol            string `json:"protocol"`
+	Direction           string `json:"direction"`
+	Source              string `json:"source"`
+	SourceOriginal      string `json:"source_original"`
+	Destination         string `json:"destination"`
+	DestinationOriginal string `json:"destination_original"`
+	State               string `json:"state"`
+}
+
+type FirewallStateEndpoint struct {
+	Address netip.Addr
+	Port    *int
+}
+
+type FirewallState struct {
+	Interface           string
+	Protocol            string
+	Direction           string
+	Source              FirewallStateEndpoint
+	SourceOriginal      *FirewallStateEndpoint
+	Destination         FirewallStateEndpoint
+	DestinationOriginal *FirewallStateEndpoint
+	State               string
+}
+
+// parseFirewallStateEndpoint parses the pf state address format, 'addr:port' for IPv4 and 'addr[port]' for IPv6.
+func parseFirewallStateEndpoint(s string) (*FirewallStateEndpoint, error) {
+	var endpoint FirewallStateEndpoint
+	addr := s
+	port := ""
+
+	if i := strings.Index(s, "["); i != -1 && strings.HasSuffix(s, "]") {
+		addr, port = s[:i], s[i+1:len(s)-1]
+	} else if strings.Count(s, ":") == 1 {
+		addr, port, _ = strings.Cut(s, ":")
+	}
+
+	a, err := netip.ParseAddr(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w state address '%s'", ErrUnableToParse, s)
+	}
+
+	endpoint.Address = a
+
+	if port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, fmt.Errorf("%w state port '%s'", ErrUnableToParse, s)
+		}
+
+		endpoint.Port = &p
+	}
+
+	return &endpoint, nil
+}
+
+func (endpoint FirewallStateEndpoint) matches(addr *netip.Addr, port *int) bool {
+	if addr != nil && endpoint.Address != *addr {
+		return false
+	}
+
+	if port != nil && (endpoint.Port == nil || *endpoint.Port != *port) {
+		return false
+	}
+
+	return true
+}
+
+type FirewallStates []FirewallState
+
+// FirewallStateFilter selects states, empty fields match any state. The address and port match either side of the state, before or after NAT.
+type FirewallStateFilter struct {
+	Interface string
+	Address   *netip.Addr
+	Port      *int
+}
+
+func (filter *FirewallStateFilter) SetInterface(iface string) error {
+	filter.Interface = iface
+
+	return nil
+}
+
+func (filter *FirewallStateFilter) SetAddress(addr string) error {
+	a, err := netip.ParseAddr(addr)
+	if err != nil {
+		return fmt.Errorf("%w, '%s' is not a valid IP address", ErrClientValidation, addr)
+	}
+
+	filter.Address = &a
+
+	return nil
+}
+
+func (filter *FirewallStateFilter) SetPort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("%w, port must be between 1 and 65535", ErrClientValidation)
+	}
+
+	filter.Port = &port
+
+	return nil
+}
+
+func (filter FirewallStateFilter) IsEmpty() bool {
+	return filter.Interface == "" && filter.Address == nil && filter.Port == nil
+}
+
+func (filter FirewallStateFilter) Matches(state FirewallState) bool {
+	if filter.Interface != "" && state.Interface != filter.Interface {
+		return false
+	}
+
+	endpoints := []*FirewallStateEndpoint{&state.Source, state.SourceOriginal, &state.Destination, state.DestinationOriginal}
+	for _, endpoint := range endpoints {
+		if endpoint != nil && endpoint.matches(filter.Address, filter.Port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (states FirewallStates) Filter(filter FirewallStateFilter) FirewallStates {
+	var filtered FirewallStates
+	for _, state := range states {
+		if filter.Matches(state) {
+			filtered = append(filtered, state)
+		}
+	}
+
+	return filtered
+}
+
+func parseFirewallStateResponse(resp firewallStateResponse) (*FirewallState, error) {
+	state := FirewallState{
+		Interface: resp.Interface,
+		Protocol:  resp.Protocol,
+		Direction: resp.Direction,
+		State:     resp.State,
+	}
+
+	source, err := parseFirewallStateEndpoint(resp.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Source = *source
+
+	destination, err := parseFirewallStateEndpoint(resp.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Destination = *destination
+
+	if resp.SourceOriginal != "" {
+		state.SourceOriginal, err = parseFirewallStateEndpoint(resp.SourceOriginal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.DestinationOriginal != "" {
+		state.DestinationOriginal, err = parseFirewallStateEndpoint(resp.DestinationOriginal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &state, nil
+}
+
+func (pf *Client) getFirewallStates(ctx context.Context) (*FirewallStates, error) {
+	command := "$output = array();" +
+		"foreach (pfSense_get_pf_states() as $s) {" +
+		"$if = convert_real_interface_to_friendly_interface_name($s['if']);" +
+		"array_push($output, array(" +
+		"'interface' => empty($if) ? $s['if'] : $if," +
+		"'protocol' => $s['proto']," +
+		"'direction' => $s['direction']," +
+		"'source' => $s['src']," +
+		"'source_original' => $s['src-orig'] ?? ''," +
+		"'destination' => $s['dst']," +
+		"'destination_original' => $s['dst-orig'] ?? ''," +
+		"'state' => $s['state']));" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var stateResp []firewallStateResponse
+	err = json.Unmarshal(b, &stateResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var states FirewallStates
+	for _, resp := range stateResp {
+		state, err := parseFirewallStateResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w state response, %w", ErrUnableToParse, err)
+		}
+
+		states = append(states, *state)
+	}
+
+	return &states, nil
+}
+
+func (pf *Client) GetFirewallStates(ctx context.Context, filter FirewallStateFilter) (*FirewallStates, error) {
+	states, err := pf.getFirewallStates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall states, %w", ErrGetOperationFailed, err)
+	}
+
+	filtered := states.Filter(filter)
+
+	return &filtered, nil
+}
+
+// KillFirewallStates kills the states matching the filter, the same way the states page does. pfSense kills by source and destination
+// address, so all states between the matched hosts are removed, not only those on the matched port. Returns the number of matched states.
+func (pf *Client) KillFirewallStates(ctx context.Context, filter FirewallStateFilter) (int, error) {
+	if filter.IsEmpty() {
+		return 0, fmt.Errorf("%w, %w, at least one of interface, address, or port is required", ErrKillFirewallStates, ErrClientValidation)
+	}
+
+	states, err := pf.getFirewallStates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w, %w", ErrKillFirewallStates, err)
+	}
+
+	matched := states.Filter(filter)
+	killed := map[[2]netip.Addr]bool{}
+
+	for _, state := range matched {
+		pair := [2]netip.Addr{state.Source.Address, state.Destination.Address}
+		if killed[pair] {
+			continue
+		}
+
+		err = pf.killFirewallStates(ctx, pair[0], pair[1])
+		if err != nil {
+			return 0, fmt.Errorf("%w, %w", ErrKillFirewallStates, err)
+		}
+
+		killed[pair] = true
+	}
+
+	return len(matched), nil
+}
+
+func (pf *Client) killFirewallStates(ctx context.Context, source netip.Addr, destination netip.Addr) error {
+	u := url.URL{Path: "diag_dump_states.php"}
+	v := url.Values{
+		"action": {"remove"},
+		"srcip":  {source.String()},
+		"dstip":  {destination.String()},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if !strings.Contains(string(b), fmt.Sprintf("|%s|%s|", source, destination)) {
+		return fmt.Errorf("unexpected response killing states from '%s' to '%s'", source, destination)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const firewallTableNameMaxLength = 31
+
+var firewallTableNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+type firewallTableResponse struct {
+	Entries     []string `json:"entries"`
+	LastUpdated *int64   `json:"last_updated"`
+}
+
+// FirewallTable is the current contents of a pf table, for alias tables these are the resolved addresses.
+type FirewallTable struct {
+	Name        string
+	Entries     []netip.Prefix
+	LastUpdated *time.Time
+}
+
+func ValidateFirewallTableName(name string) error {
+	if len(name) == 0 || len(name) > firewallTableNameMaxLength || !firewallTableNameRegex.MatchString(name) {
+		return fmt.Errorf("%w, table name '%s' must be 1 to %d letters, digits, or underscores", ErrClientValidation, name, firewallTableNameMaxLength)
+	}
+
+	return nil
+}
+
+func parseFirewallTableEntry(entry string) (netip.Prefix, error) {
+	if strings.Contains(entry, "/") {
+		return netip.ParsePrefix(entry)
+	}
+
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// GetFirewallTable returns the entries of a pf table as shown by diag_tables.php. The last update time is only known for URL table
+// aliases and the bogons tables, which are loaded from files.
+func (pf *Client) GetFirewallTable(ctx context.Context, name string) (*FirewallTable, error) {
+	err := ValidateFirewallTableName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall table, %w", ErrGetOperationFailed, err)
+	}
+
+	command := fmt.Sprintf("$name = '%s';", name) +
+		"exec('/sbin/pfctl -sT', $tables);" +
+		"if (!in_array($name, $tables)) { print_r(json_encode(null)); } else {" +
+		"exec('/sbin/pfctl -t ' . escapeshellarg($name) . ' -T show', $entries);" +
+		"$file = in_array($name, array('bogons', 'bogonsv6')) ? \"/etc/{$name}\" : \"/var/db/aliastables/{$name}.txt\";" +
+		"print_r(json_encode(array('entries' => array_map('trim', $entries), 'last_updated' => file_exists($file) ? filemtime($file) : null)));" +
+		"};"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall table, %w", ErrGetOperationFailed, err)
+	}
+
+	var tableResp *firewallTableResponse
+	err = json.Unmarshal(b, &tableResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall table, %w, %w", ErrGetOperationFailed, ErrUnableToParse, err)
+	}
+
+	if tableResp == nil {
+		return nil, fmt.Errorf("firewall table %w with name '%s'", ErrNotFound, name)
+	}
+
+	table := FirewallTable{Name: name}
+
+	for _, entry := range tableResp.Entries {
+		if entry == "" {
+			continue
+		}
+
+		prefix, err := parseFirewallTableEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w firewall table entry '%s', %w", ErrUnableToParse, entry, err)
+		}
+
+		table.Entries = append(table.Entries, prefix)
+	}
+
+	if tableResp.LastUpdated != nil {
+		lastUpdated := time.Unix(*tableResp.LastUpdated, 0).UTC()
+		table.LastUpdated = &lastUpdated
+	}
+
+	return &table, nil
+}
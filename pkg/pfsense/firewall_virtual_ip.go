@@ -0,0 +1,561 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrApplyVirtualIPChange = errors.New("failed to apply virtual IP changes")
+)
+
+const (
+	VirtualIPModeIPAlias  = "ipalias"
+	VirtualIPModeCARP     = "carp"
+	VirtualIPModeProxyARP = "proxyarp"
+	VirtualIPModeOther    = "other"
+
+	DefaultVirtualIPAdvertisementBase = 1
+	DefaultVirtualIPAdvertisementSkew = 0
+)
+
+const (
+	virtualIPTypeSingle  = "single"
+	virtualIPTypeNetwork = "network"
+	virtualIPFlag        = "yes"
+)
+
+var VirtualIPModes = []string{VirtualIPModeIPAlias, VirtualIPModeCARP, VirtualIPModeProxyARP, VirtualIPModeOther}
+
+type virtualIPResponse struct {
+	Mode        string `json:"mode"`
+	Interface   string `json:"interface"`
+	UniqID      string `json:"uniqid"`
+	Description string `json:"descr"`
+	Subnet      string `json:"subnet"`
+	SubnetBits  string `json:"subnet_bits"`
+	NoExpand    string `json:"noexpand"`
+	VHID        string `json:"vhid"`
+	AdvBase     string `json:"advbase"`
+	AdvSkew     string `json:"advskew"`
+	ControlID   int    `json:"controlID"`
+}
+
+type FirewallVirtualIP struct {
+	ID                string
+	Mode              string
+	Interface         string
+	Address           netip.Prefix
+	NoExpand          bool
+	VHID              *int
+	AdvertisementBase *int
+	AdvertisementSkew *int
+	Password          string
+	Description       string
+	controlID         int
+}
+
+// newVirtualIPID returns an identifier in the format of the PHP uniqid function, which pfSense uses for virtual IPs.
+func newVirtualIPID() string {
+	now := time.Now()
+
+	return fmt.Sprintf("%08x%05x", now.Unix(), now.Nanosecond()/1000)
+}
+
+func (vip *FirewallVirtualIP) SetMode(mode string) error {
+	for _, m := range VirtualIPModes {
+		if mode == m {
+			vip.Mode = mode
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, mode must be one of '%s'", ErrClientValidation, strings.Join(VirtualIPModes, "', '"))
+}
+
+func (vip *FirewallVirtualIP) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, interface is required", ErrClientValidation)
+	}
+
+	vip.Interface = iface
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetAddress(addr string) error {
+	prefix, err := netip.ParsePrefix(addr)
+	if err != nil {
+		return fmt.Errorf("%w, address '%s' must be in CIDR notation, for example '192.168.1.10/24'", ErrClientValidation, addr)
+	}
+
+	vip.Address = prefix
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetNoExpand(noExpand bool) error {
+	vip.NoExpand = noExpand
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetVHID(vhid int) error {
+	if vhid < 1 || vhid > 255 {
+		return fmt.Errorf("%w, VHID must be between 1 and 255", ErrClientValidation)
+	}
+
+	vip.VHID = &vhid
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetAdvertisementBase(base int) error {
+	if base < 1 || base > 254 {
+		return fmt.Errorf("%w, advertisement base must be between 1 and 254", ErrClientValidation)
+	}
+
+	vip.AdvertisementBase = &base
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetAdvertisementSkew(skew int) error {
+	if skew < 0 || skew > 254 {
+		return fmt.Errorf("%w, advertisement skew must be between 0 and 254", ErrClientValidation)
+	}
+
+	vip.AdvertisementSkew = &skew
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetPassword(password string) error {
+	vip.Password = password
+
+	return nil
+}
+
+func (vip *FirewallVirtualIP) SetDescription(description string) error {
+	vip.Description = description
+
+	return nil
+}
+
+// Validate checks the settings which only apply to some modes, the CARP password is required because it is never read back.
+func (vip FirewallVirtualIP) Validate() error {
+	carp := vip.Mode == VirtualIPModeCARP
+
+	if carp && vip.VHID == nil {
+		return fmt.Errorf("%w, CARP virtual IPs require a VHID", ErrClientValidation)
+	}
+
+	if carp && vip.Password == "" {
+		return fmt.Errorf("%w, CARP virtual IPs require a password", ErrClientValidation)
+	}
+
+	if !carp && (vip.VHID != nil || vip.AdvertisementBase != nil || vip.AdvertisementSkew != nil || vip.Password != "") {
+		return fmt.Errorf("%w, VHID, advertisement base and skew, and password only apply to CARP virtual IPs", ErrClientValidation)
+	}
+
+	network := vip.Mode == VirtualIPModeProxyARP || vip.Mode == VirtualIPModeOther
+	if network && !vip.Address.IsSingleIP() && vip.Address.Masked() != vip.Address {
+		return fmt.Errorf("%w, network '%s' has host bits set, use '%s'", ErrClientValidation, vip.Address, vip.Address.Masked())
+	}
+
+	if vip.NoExpand && (vip.Mode != VirtualIPModeProxyARP || vip.Address.IsSingleIP()) {
+		return fmt.Errorf("%w, disabling expansion only applies to proxy ARP networks", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (vip FirewallVirtualIP) formValues() url.Values {
+	vipType := virtualIPTypeSingle
+	if (vip.Mode == VirtualIPModeProxyARP || vip.Mode == VirtualIPModeOther) && !vip.Address.IsSingleIP() {
+		vipType = virtualIPTypeNetwork
+	}
+
+	v := url.Values{
+		"mode":        {vip.Mode},
+		"interface":   {vip.Interface},
+		"type":        {vipType},
+		"subnet":      {vip.Address.Addr().String()},
+		"subnet_bits": {strconv.Itoa(vip.Address.Bits())},
+		"descr":       {vip.Description},
+		"uniqid":      {vip.ID},
+		"save":        {"Save"},
+	}
+
+	if vip.NoExpand {
+		v.Set("noexpand", virtualIPFlag)
+	}
+
+	if vip.Mode == VirtualIPModeCARP {
+		advBase := DefaultVirtualIPAdvertisementBase
+		if vip.AdvertisementBase != nil {
+			advBase = *vip.AdvertisementBase
+		}
+
+		advSkew := DefaultVirtualIPAdvertisementSkew
+		if vip.AdvertisementSkew != nil {
+			advSkew = *vip.AdvertisementSkew
+		}
+
+		v.Set("vhid", strconv.Itoa(*vip.VHID))
+		v.Set("advbase", strconv.Itoa(advBase))
+		v.Set("advskew", strconv.Itoa(advSkew))
+		v.Set("password", vip.Password)
+		v.Set("password_confirm", vip.Password)
+	}
+
+	return v
+}
+
+type FirewallVirtualIPs []FirewallVirtualIP
+
+func (vips FirewallVirtualIPs) GetByID(id string) (*FirewallVirtualIP, error) {
+	for _, v := range vips {
+		if v.ID == id {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("virtual IP %w with ID '%s'", ErrNotFound, id)
+}
+
+func (vips FirewallVirtualIPs) GetControlIDByID(id string) (*int, error) {
+	for _, v := range vips {
+		if v.ID == id {
+			return &v.controlID, nil
+		}
+	}
+
+	return nil, fmt.Errorf("virtual IP %w with ID '%s'", ErrNotFound, id)
+}
+
+func parseVirtualIPResponse(resp virtualIPResponse) (*FirewallVirtualIP, error) {
+	var vip FirewallVirtualIP
+	var err error
+
+	vip.ID = resp.UniqID
+	vip.controlID = resp.ControlID
+
+	err = vip.SetMode(resp.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetAddress(fmt.Sprintf("%s/%s", resp.Subnet, resp.SubnetBits))
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetNoExpand(resp.NoExpand != "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetDescription(html.UnescapeString(resp.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	if vip.Mode != VirtualIPModeCARP {
+		return &vip, nil
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		set   func(int) error
+	}{
+		{"VHID", resp.VHID, vip.SetVHID},
+		{"advertisement base", resp.AdvBase, vip.SetAdvertisementBase},
+		{"advertisement skew", resp.AdvSkew, vip.SetAdvertisementSkew},
+	} {
+		i, err := strconv.Atoi(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w virtual IP %s '%s'", ErrUnableToParse, field.name, field.value)
+		}
+
+		err = field.set(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &vip, nil
+}
+
+func (pf *Client) getFirewallVirtualIPs(ctx context.Context) (*FirewallVirtualIPs, error) {
+	command := "$output = array();" +
+		"foreach ($config['virtualip']['vip'] ?? array() as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"};" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var vipResp []virtualIPResponse
+	err = json.Unmarshal(b, &vipResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var vips FirewallVirtualIPs
+	for _, resp := range vipResp {
+		vip, err := parseVirtualIPResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w virtual IP response, %w", ErrUnableToParse, err)
+		}
+
+		vips = append(vips, *vip)
+	}
+
+	return &vips, nil
+}
+
+func (pf *Client) GetFirewallVirtualIPs(ctx context.Context) (*FirewallVirtualIPs, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IPs, %w", ErrGetOperationFailed, err)
+	}
+
+	return vips, nil
+}
+
+func (pf *Client) GetFirewallVirtualIP(ctx context.Context, id string) (*FirewallVirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP (id '%s'), %w", ErrGetOperationFailed, id, err)
+	}
+
+	return vips.GetByID(id)
+}
+
+func (pf *Client) createOrUpdateFirewallVirtualIP(ctx context.Context, vipReq FirewallVirtualIP, controlID *int) (*FirewallVirtualIP, error) {
+	err := vipReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "firewall_virtual_ip_edit.php"}
+	v := vipReq.formValues()
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vip, err := vips.GetByID(vipReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return vip, nil
+}
+
+func (pf *Client) CreateFirewallVirtualIP(ctx context.Context, vipReq FirewallVirtualIP) (*FirewallVirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vipReq.ID = newVirtualIPID()
+
+	vip, err := pf.createOrUpdateFirewallVirtualIP(ctx, vipReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrCreateOperationFailed, err)
+	}
+
+	return vip, nil
+}
+
+func (pf *Client) UpdateFirewallVirtualIP(ctx context.Context, vipReq FirewallVirtualIP) (*FirewallVirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := vips.GetControlIDByID(vipReq.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	vip, err := pf.createOrUpdateFirewallVirtualIP(ctx, vipReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return vip, nil
+}
+
+func (pf *Client) DeleteFirewallVirtualIP(ctx context.Context, id string) error {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := vips.GetControlIDByID(id)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_virtual_ip.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	vips, err = pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete virtual IPs used by NAT rules or IP aliases on a CARP virtual IP
+	if _, err = vips.GetByID(id); err == nil {
+		return fmt.Errorf("%w virtual IP, '%s' still exists, it may be in use", ErrDeleteOperationFailed, id)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplyFirewallVirtualIPChanges(ctx context.Context) error {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	u := url.URL{Path: "firewall_virtual_ip.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyVirtualIPChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+func (pf *Client) getCARPMaintenanceMode(ctx context.Context) (bool, error) {
+	b, err := pf.runPHPCommand(ctx, "print_r(json_encode(isset($config['virtualip_carp_maintenancemode'])));")
+	if err != nil {
+		return false, err
+	}
+
+	var enabled bool
+	err = json.Unmarshal(b, &enabled)
+	if err != nil {
+		return false, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	return enabled, nil
+}
+
+func (pf *Client) GetCARPMaintenanceMode(ctx context.Context) (bool, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	enabled, err := pf.getCARPMaintenanceMode(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w CARP maintenance mode, %w", ErrGetOperationFailed, err)
+	}
+
+	return enabled, nil
+}
+
+// SetCARPMaintenanceMode enters or leaves persistent CARP maintenance mode, the status page only offers a toggle so the current mode is checked first.
+func (pf *Client) SetCARPMaintenanceMode(ctx context.Context, enabled bool) error {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	current, err := pf.getCARPMaintenanceMode(ctx)
+	if err != nil {
+		return fmt.Errorf("%w CARP maintenance mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if current == enabled {
+		return nil
+	}
+
+	u := url.URL{Path: "status_carp.php"}
+	v := url.Values{
+		"carp_maintenancemode": {"toggle"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w CARP maintenance mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	current, err = pf.getCARPMaintenanceMode(ctx)
+	if err != nil {
+		return fmt.Errorf("%w CARP maintenance mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if current != enabled {
+		return fmt.Errorf("%w CARP maintenance mode, mode did not change", ErrUpdateOperationFailed)
+	}
+
+	return nil
+}
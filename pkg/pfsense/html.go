@@ -2,6 +2,7 @@ package pfsense
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -21,6 +22,59 @@ func scrapeHTMLValidationErrors(doc *goquery.Document) error {
 	return nil
 }
 
+func scrapeHTMLApplyErrors(doc *goquery.Document) error {
+	alert := doc.FindMatcher(goquery.Single("div.alert-danger:contains('applying the changes')"))
+	if alert.Length() != 0 {
+		return fmt.Errorf("%w, '%s'", ErrServerValidation, strings.Join(strings.Fields(alert.Text()), " "))
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+// some pages reset fields which are not submitted, so everything a browser would submit is posted.
+func scrapeHTMLFormValues(doc *goquery.Document, selector string) (url.Values, error) {
+	form := doc.FindMatcher(goquery.Single(selector))
+	if form.Length() != 1 {
+		return nil, fmt.Errorf("%w, form '%s' not found", ErrUnableToScrapeHTML, selector)
+	}
+
+	v := url.Values{}
+
+	form.Find("input[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		value, _ := e.Attr("value")
+
+		switch strings.ToLower(e.AttrOr("type", "text")) {
+		case "checkbox", "radio":
+			if _, checked := e.Attr("checked"); checked {
+				v.Add(name, e.AttrOr("value", "on"))
+			}
+		case "submit", "button", "reset", "image", "file":
+		default:
+			v.Add(name, value)
+		}
+	})
+
+	form.Find("select[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		selected := e.Find("option[selected]")
+		if _, multiple := e.Attr("multiple"); selected.Length() == 0 && !multiple {
+			selected = e.Find("option").First()
+		}
+
+		selected.Each(func(i int, o *goquery.Selection) {
+			v.Add(name, o.AttrOr("value", strings.TrimSpace(o.Text())))
+		})
+	})
+
+	form.Find("textarea[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		v.Add(name, e.Text())
+	})
+
+	return v, nil
+}
+
 func sanitizeHTMLMessage(text string) (string, error) {
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(text))
 	if err != nil {